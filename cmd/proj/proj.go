@@ -110,9 +110,15 @@ func Main(inS io.Reader, outS io.Writer, args []string) error {
 		return err
 	}
 
-	// we only support one kind of operation object right now anyway
+	// we only support one kind of operation object here
+	if !opx.GetDescription().IsConvertLPToXY() {
+		return fmt.Errorf("operation is not a 2D projection")
+	}
 	op := opx.(core.IConvertLPToXY)
 
+	// lat/long "projections" read and write degrees on both sides
+	angular := opx.GetSystem().Right == core.IOUnitsAngular
+
 	// make a lambda with the forward or inverse function, and
 	// send it to the REPL loop
 	if !*inverse {
@@ -123,6 +129,9 @@ func Main(inS io.Reader, outS io.Writer, args []string) error {
 			if err != nil {
 				return 0.0, 0.0, err
 			}
+			if angular {
+				return support.RToDD(output.X), support.RToDD(output.Y), nil
+			}
 			return output.X, output.Y, nil
 		}
 		return repl(inS, outS, f)
@@ -130,6 +139,9 @@ func Main(inS io.Reader, outS io.Writer, args []string) error {
 
 	f := func(a, b float64) (float64, float64, error) {
 		input := &core.CoordXY{X: a, Y: b}
+		if angular {
+			input.X, input.Y = support.DDToR(a), support.DDToR(b)
+		}
 		output, err := op.Inverse(input)
 		if err != nil {
 			return 0.0, 0.0, err
@@ -18,9 +18,9 @@ import (
 //
 // This interface requires you support a forward LP-to-XY
 // function and an inverse XY-to-LP function. This is
-// the only conversion type we support today; someday,
-// there will be more interfaces like this, for different
-// input/output types.
+// the conversion type nearly all the operations use; the
+// others, like IConvertLPZToXYZ, follow the same pattern
+// for different input/output types.
 //
 // (Yes, sometimes my interface names still start with "I".
 // Everyone has their own personal moral failings, and this
@@ -120,49 +120,7 @@ func (op *ConvertLPToXY) forwardPrepare(lp *CoordLP) (*CoordLP, error) {
 
 	/* Check validity of angular input coordinates */
 	if sys.Left == IOUnitsAngular {
-
-		/* check for latitude or longitude over-range */
-		var t float64
-		if lp.Phi < 0 {
-			t = -lp.Phi - support.PiOverTwo
-		} else {
-			t = lp.Phi - support.PiOverTwo
-		}
-		if t > epsLat || lp.Lam > 10 || lp.Lam < -10 {
-			return nil, merror.New(merror.LatOrLonExceededLimit)
-		}
-
-		/* Clamp latitude to -90..90 degree range */
-		if lp.Phi > support.PiOverTwo {
-			lp.Phi = support.PiOverTwo
-		}
-		if lp.Phi < -support.PiOverTwo {
-			lp.Phi = -support.PiOverTwo
-		}
-
-		/* If input latitude is geocentrical, convert to geographical */
-		if sys.Geoc {
-			lp = GeocentricLatitude(sys, DirectionInverse, lp)
-		}
-
-		/* Ensure longitude is in the -pi:pi range */
-		if !sys.Over {
-			lp.Lam = support.Adjlon(lp.Lam)
-		}
-
-		if lp.Lam == math.MaxFloat64 {
-			return lp, nil
-		}
-
-		/* Distance from central meridian, taking system zero meridian into account */
-		lp.Lam = (lp.Lam - sys.FromGreenwich) - sys.Lam0
-
-		/* Ensure longitude is in the -pi:pi range */
-		if !sys.Over {
-			lp.Lam = support.Adjlon(lp.Lam)
-		}
-
-		return lp, nil
+		return forwardPrepareAngular(sys, lp)
 	}
 
 	return lp, nil
@@ -248,18 +206,73 @@ func (op *ConvertLPToXY) inverseFinalize(coo *CoordLP) (*CoordLP, error) {
 		return coo, nil
 	}
 
-	if sys.Right != IOUnitsAngular {
-		/* Distance from central meridian, taking system zero meridian into account */
-		coo.Lam = coo.Lam + sys.FromGreenwich + sys.Lam0
+	return inverseFinalizeAngular(sys, coo), nil
+}
+
+//---------------------------------------------------------------------
 
-		/* adjust longitude to central meridian */
-		if !sys.Over {
-			coo.Lam = support.Adjlon(coo.Lam)
-		}
+// forwardPrepareAngular checks and adjusts angular input coordinates
+// before they are handed to a forward algorithm
+func forwardPrepareAngular(sys *System, lp *CoordLP) (*CoordLP, error) {
 
-		if coo.Lam == math.MaxFloat64 {
-			return coo, nil
-		}
+	/* check for latitude or longitude over-range */
+	var t float64
+	if lp.Phi < 0 {
+		t = -lp.Phi - support.PiOverTwo
+	} else {
+		t = lp.Phi - support.PiOverTwo
+	}
+	if t > epsLat || lp.Lam > 10 || lp.Lam < -10 {
+		return nil, merror.New(merror.LatOrLonExceededLimit)
+	}
+
+	/* Clamp latitude to -90..90 degree range */
+	if lp.Phi > support.PiOverTwo {
+		lp.Phi = support.PiOverTwo
+	}
+	if lp.Phi < -support.PiOverTwo {
+		lp.Phi = -support.PiOverTwo
+	}
+
+	/* If input latitude is geocentrical, convert to geographical */
+	if sys.Geoc {
+		lp = GeocentricLatitude(sys, DirectionInverse, lp)
+	}
+
+	/* Ensure longitude is in the -pi:pi range */
+	if !sys.Over {
+		lp.Lam = support.Adjlon(lp.Lam)
+	}
+
+	if lp.Lam == math.MaxFloat64 {
+		return lp, nil
+	}
+
+	/* Distance from central meridian, taking system zero meridian into account */
+	lp.Lam = (lp.Lam - sys.FromGreenwich) - sys.Lam0
+
+	/* Ensure longitude is in the -pi:pi range */
+	if !sys.Over {
+		lp.Lam = support.Adjlon(lp.Lam)
+	}
+
+	return lp, nil
+}
+
+// inverseFinalizeAngular adjusts angular output coordinates after they
+// are returned from an inverse algorithm
+func inverseFinalizeAngular(sys *System, coo *CoordLP) *CoordLP {
+
+	/* Distance from central meridian, taking system zero meridian into account */
+	coo.Lam = coo.Lam + sys.FromGreenwich + sys.Lam0
+
+	/* adjust longitude to central meridian */
+	if !sys.Over {
+		coo.Lam = support.Adjlon(coo.Lam)
+	}
+
+	if coo.Lam == math.MaxFloat64 {
+		return coo
 	}
 
 	/* If input latitude was geocentrical, convert back to geocentrical */
@@ -267,5 +280,5 @@ func (op *ConvertLPToXY) inverseFinalize(coo *CoordLP) (*CoordLP, error) {
 		coo = GeocentricLatitude(sys, DirectionForward, coo)
 	}
 
-	return coo, nil
+	return coo
 }
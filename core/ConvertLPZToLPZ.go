@@ -0,0 +1,100 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package core
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/merror"
+)
+
+// IConvertLPZToLPZ is for conversions which take geodetic coordinates
+// (with heights) to geodetic coordinates, such as small offsets
+// between datums
+type IConvertLPZToLPZ interface {
+	IOperation
+	Forward(*CoordLPZ) (*CoordLPZ, error)
+	Inverse(*CoordLPZ) (*CoordLPZ, error)
+}
+
+// ConvertLPZToLPZ is a specific kind of operation, which satisfies
+// the IConvertLPZToLPZ interface.
+//
+// Both sides are angular: the algorithm sees longitudes relative to the
+// central and prime meridians, and heights relative to z_0, and the hooks
+// undo that on the way out in both directions.
+type ConvertLPZToLPZ struct {
+	Operation
+	Algorithm IConvertLPZToLPZ
+}
+
+// NewConvertLPZToLPZ makes a new ConvertLPZToLPZ operation and its associated
+// algorithm object, and returns the operation as an IOperation.
+func NewConvertLPZToLPZ(sys *System, desc *OperationDescription) (IOperation, error) {
+
+	if !desc.IsConvertLPZToLPZ() {
+		return nil, merror.New(merror.NotYetSupported)
+	}
+
+	op := &ConvertLPZToLPZ{}
+	op.Description = desc
+	op.System = sys
+
+	f := desc.creatorFunc.(ConvertLPZToLPZCreatorFuncType)
+	obj, err := f(sys, desc)
+	if err != nil {
+		return nil, err
+	}
+	op.Algorithm = obj
+
+	return op, nil
+}
+
+//---------------------------------------------------------------------
+
+// Forward is the hook-providing entry point to the algorithm.
+func (op *ConvertLPZToLPZ) Forward(lpz *CoordLPZ) (*CoordLPZ, error) {
+
+	lpz, err := op.prepare(lpz)
+	if err != nil {
+		return nil, err
+	}
+
+	lpz, err = op.Algorithm.Forward(lpz)
+	if err != nil {
+		return nil, err
+	}
+
+	return inverseFinalizeAngularZ(op.System, lpz), nil
+}
+
+// Inverse is the hook-providing entry point to the inverse algorithm.
+func (op *ConvertLPZToLPZ) Inverse(lpz *CoordLPZ) (*CoordLPZ, error) {
+
+	lpz, err := op.prepare(lpz)
+	if err != nil {
+		return nil, err
+	}
+
+	lpz, err = op.Algorithm.Inverse(lpz)
+	if err != nil {
+		return nil, err
+	}
+
+	return inverseFinalizeAngularZ(op.System, lpz), nil
+}
+
+// prepare is called just before calling Forward() or Inverse()
+func (op *ConvertLPZToLPZ) prepare(lpz *CoordLPZ) (*CoordLPZ, error) {
+
+	if math.MaxFloat64 == lpz.Lam {
+		return nil, merror.New(merror.CoordinateError)
+	}
+
+	return forwardPrepareAngularZ(op.System, lpz)
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package core
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/merror"
+)
+
+// IConvertLPZToXYZ is for 3D LPZ->XYZ conversions, such as
+// geodetic coordinates (with ellipsoidal heights) to geocentric
+// cartesian coordinates
+type IConvertLPZToXYZ interface {
+	IOperation
+	Forward(*CoordLPZ) (*CoordXYZ, error)
+	Inverse(*CoordXYZ) (*CoordLPZ, error)
+}
+
+// ConvertLPZToXYZ is a specific kind of operation, which satisfies
+// the IConvertLPZToXYZ interface.
+//
+// As with ConvertLPToXY, it wraps the algorithm's forward and inverse
+// functions with the prepare and finalize hooks.
+type ConvertLPZToXYZ struct {
+	Operation
+	Algorithm IConvertLPZToXYZ
+}
+
+// NewConvertLPZToXYZ makes a new ConvertLPZToXYZ operation and its associated
+// algorithm object, and returns the operation as an IOperation.
+func NewConvertLPZToXYZ(sys *System, desc *OperationDescription) (IOperation, error) {
+
+	if !desc.IsConvertLPZToXYZ() {
+		return nil, merror.New(merror.NotYetSupported)
+	}
+
+	op := &ConvertLPZToXYZ{}
+	op.Description = desc
+	op.System = sys
+
+	f := desc.creatorFunc.(ConvertLPZToXYZCreatorFuncType)
+	obj, err := f(sys, desc)
+	if err != nil {
+		return nil, err
+	}
+	op.Algorithm = obj
+
+	return op, nil
+}
+
+//---------------------------------------------------------------------
+
+// Forward is the hook-providing entry point to the algorithm.
+func (op *ConvertLPZToXYZ) Forward(lpz *CoordLPZ) (*CoordXYZ, error) {
+
+	lpz, err := op.forwardPrepare(lpz)
+	if err != nil {
+		return nil, err
+	}
+
+	xyz, err := op.Algorithm.Forward(lpz)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.forwardFinalize(xyz), nil
+}
+
+// Inverse is the hook-providing entry point to the inverse algorithm.
+func (op *ConvertLPZToXYZ) Inverse(xyz *CoordXYZ) (*CoordLPZ, error) {
+
+	xyz, err := op.inversePrepare(xyz)
+	if err != nil {
+		return nil, err
+	}
+
+	lpz, err := op.Algorithm.Inverse(xyz)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.inverseFinalize(lpz), nil
+}
+
+// forwardPrepare is called just before calling Forward()
+func (op *ConvertLPZToXYZ) forwardPrepare(lpz *CoordLPZ) (*CoordLPZ, error) {
+
+	sys := op.System
+
+	if math.MaxFloat64 == lpz.Lam {
+		return nil, merror.New(merror.CoordinateError)
+	}
+
+	if sys.Left != IOUnitsAngular {
+		return lpz, nil
+	}
+
+	return forwardPrepareAngularZ(sys, lpz)
+}
+
+// forwardFinalize is called just after calling Forward()
+func (op *ConvertLPZToXYZ) forwardFinalize(xyz *CoordXYZ) *CoordXYZ {
+
+	sys := op.System
+
+	if sys.Right == IOUnitsCartesian {
+		xyz.X *= sys.FromMeter
+		xyz.Y *= sys.FromMeter
+		xyz.Z *= sys.FromMeter
+	}
+
+	return xyz
+}
+
+// inversePrepare is called just before calling Inverse()
+func (op *ConvertLPZToXYZ) inversePrepare(xyz *CoordXYZ) (*CoordXYZ, error) {
+
+	sys := op.System
+
+	if xyz.X == math.MaxFloat64 {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+
+	if sys.Right == IOUnitsCartesian {
+		xyz = &CoordXYZ{
+			X: sys.ToMeter * xyz.X,
+			Y: sys.ToMeter * xyz.Y,
+			Z: sys.ToMeter * xyz.Z,
+		}
+	}
+
+	return xyz, nil
+}
+
+// inverseFinalize is called just after calling Inverse()
+func (op *ConvertLPZToXYZ) inverseFinalize(lpz *CoordLPZ) *CoordLPZ {
+
+	sys := op.System
+
+	if sys.Left != IOUnitsAngular {
+		return lpz
+	}
+
+	return inverseFinalizeAngularZ(sys, lpz)
+}
+
+//---------------------------------------------------------------------
+
+// forwardPrepareAngularZ is forwardPrepareAngular for coordinates
+// with heights
+func forwardPrepareAngularZ(sys *System, lpz *CoordLPZ) (*CoordLPZ, error) {
+
+	lp, err := forwardPrepareAngular(sys, &CoordLP{Lam: lpz.Lam, Phi: lpz.Phi})
+	if err != nil {
+		return nil, err
+	}
+
+	/* Heights are in vertical units, relative to z_0 */
+	z := sys.VToMeter*lpz.Z - sys.Z0
+
+	return &CoordLPZ{Lam: lp.Lam, Phi: lp.Phi, Z: z}, nil
+}
+
+// inverseFinalizeAngularZ is inverseFinalizeAngular for coordinates
+// with heights
+func inverseFinalizeAngularZ(sys *System, lpz *CoordLPZ) *CoordLPZ {
+
+	lp := inverseFinalizeAngular(sys, &CoordLP{Lam: lpz.Lam, Phi: lpz.Phi})
+	z := sys.VFromMeter * (lpz.Z + sys.Z0)
+
+	return &CoordLPZ{Lam: lp.Lam, Phi: lp.Phi, Z: z}
+}
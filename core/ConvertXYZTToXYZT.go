@@ -0,0 +1,77 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package core
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/merror"
+)
+
+// IConvertXYZTToXYZT is for conversions which don't care what their
+// coordinates are, such as affine transformations and polynomials.
+// Operations which only use X and Y pass Z and T through.
+type IConvertXYZTToXYZT interface {
+	IOperation
+	Forward(*CoordXYZT) (*CoordXYZT, error)
+	Inverse(*CoordXYZT) (*CoordXYZT, error)
+}
+
+// ConvertXYZTToXYZT is a specific kind of operation, which satisfies
+// the IConvertXYZTToXYZT interface.
+//
+// The units on both sides are "whatever", so there is nothing for the
+// hooks to do beyond rejecting invalid coordinates.
+type ConvertXYZTToXYZT struct {
+	Operation
+	Algorithm IConvertXYZTToXYZT
+}
+
+// NewConvertXYZTToXYZT makes a new ConvertXYZTToXYZT operation and its associated
+// algorithm object, and returns the operation as an IOperation.
+func NewConvertXYZTToXYZT(sys *System, desc *OperationDescription) (IOperation, error) {
+
+	if !desc.IsConvertXYZTToXYZT() {
+		return nil, merror.New(merror.NotYetSupported)
+	}
+
+	op := &ConvertXYZTToXYZT{}
+	op.Description = desc
+	op.System = sys
+
+	f := desc.creatorFunc.(ConvertXYZTToXYZTCreatorFuncType)
+	obj, err := f(sys, desc)
+	if err != nil {
+		return nil, err
+	}
+	op.Algorithm = obj
+
+	return op, nil
+}
+
+//---------------------------------------------------------------------
+
+// Forward is the hook-providing entry point to the algorithm.
+func (op *ConvertXYZTToXYZT) Forward(xyzt *CoordXYZT) (*CoordXYZT, error) {
+
+	if xyzt.X == math.MaxFloat64 {
+		return nil, merror.New(merror.CoordinateError)
+	}
+
+	return op.Algorithm.Forward(xyzt)
+}
+
+// Inverse is the hook-providing entry point to the inverse algorithm.
+func (op *ConvertXYZTToXYZT) Inverse(xyzt *CoordXYZT) (*CoordXYZT, error) {
+
+	if xyzt.X == math.MaxFloat64 {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+
+	return op.Algorithm.Inverse(xyzt)
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package core
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/merror"
+)
+
+// IConvertXYZToENU is for conversions from 3D cartesian coordinates
+// to a local East-North-Up frame
+type IConvertXYZToENU interface {
+	IOperation
+	Forward(*CoordXYZ) (*CoordENU, error)
+	Inverse(*CoordENU) (*CoordXYZ, error)
+}
+
+// ConvertXYZToENU is a specific kind of operation, which satisfies
+// the IConvertXYZToENU interface.
+//
+// Both sides are cartesian, so the hooks only deal with the linear units
+// of the local frame.
+type ConvertXYZToENU struct {
+	Operation
+	Algorithm IConvertXYZToENU
+}
+
+// NewConvertXYZToENU makes a new ConvertXYZToENU operation and its associated
+// algorithm object, and returns the operation as an IOperation.
+func NewConvertXYZToENU(sys *System, desc *OperationDescription) (IOperation, error) {
+
+	if !desc.IsConvertXYZToENU() {
+		return nil, merror.New(merror.NotYetSupported)
+	}
+
+	op := &ConvertXYZToENU{}
+	op.Description = desc
+	op.System = sys
+
+	f := desc.creatorFunc.(ConvertXYZToENUCreatorFuncType)
+	obj, err := f(sys, desc)
+	if err != nil {
+		return nil, err
+	}
+	op.Algorithm = obj
+
+	return op, nil
+}
+
+//---------------------------------------------------------------------
+
+// Forward is the hook-providing entry point to the algorithm.
+func (op *ConvertXYZToENU) Forward(xyz *CoordXYZ) (*CoordENU, error) {
+
+	sys := op.System
+
+	if xyz.X == math.MaxFloat64 {
+		return nil, merror.New(merror.CoordinateError)
+	}
+
+	enu, err := op.Algorithm.Forward(xyz)
+	if err != nil {
+		return nil, err
+	}
+
+	enu.E *= sys.FromMeter
+	enu.N *= sys.FromMeter
+	enu.U *= sys.FromMeter
+
+	return enu, nil
+}
+
+// Inverse is the hook-providing entry point to the inverse algorithm.
+func (op *ConvertXYZToENU) Inverse(enu *CoordENU) (*CoordXYZ, error) {
+
+	sys := op.System
+
+	if enu.E == math.MaxFloat64 {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+
+	enu = &CoordENU{
+		E: sys.ToMeter * enu.E,
+		N: sys.ToMeter * enu.N,
+		U: sys.ToMeter * enu.U,
+	}
+
+	xyz, err := op.Algorithm.Inverse(enu)
+	if err != nil {
+		return nil, err
+	}
+
+	return xyz, nil
+}
@@ -12,7 +12,8 @@ type CoordType int
 
 // The coordinate types
 //
-// Today we are only using CoordTypeLP and CoordTypeXY.
+// Today we are only using CoordTypeLP, CoordTypeXY, CoordTypeLPZ,
+// CoordTypeXYZ, CoordTypeXYZT and CoordTypeENU.
 const (
 	CoordTypeAny = iota
 	CoordTypeXYZT
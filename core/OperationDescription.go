@@ -25,6 +25,22 @@ var OperationDescriptionTable = map[string]*OperationDescription{}
 // which implements IConvertLPToXY.
 type ConvertLPToXYCreatorFuncType func(*System, *OperationDescription) (IConvertLPToXY, error)
 
+// ConvertLPZToXYZCreatorFuncType is the type of the function which creates
+// an operation-specific object which implements IConvertLPZToXYZ.
+type ConvertLPZToXYZCreatorFuncType func(*System, *OperationDescription) (IConvertLPZToXYZ, error)
+
+// ConvertXYZToENUCreatorFuncType is the type of the function which creates
+// an operation-specific object which implements IConvertXYZToENU.
+type ConvertXYZToENUCreatorFuncType func(*System, *OperationDescription) (IConvertXYZToENU, error)
+
+// ConvertLPZToLPZCreatorFuncType is the type of the function which creates
+// an operation-specific object which implements IConvertLPZToLPZ.
+type ConvertLPZToLPZCreatorFuncType func(*System, *OperationDescription) (IConvertLPZToLPZ, error)
+
+// ConvertXYZTToXYZTCreatorFuncType is the type of the function which creates
+// an operation-specific object which implements IConvertXYZTToXYZT.
+type ConvertXYZTToXYZTCreatorFuncType func(*System, *OperationDescription) (IConvertXYZTToXYZT, error)
+
 // OperationDescription stores the information about a particular kind of
 // operation. It is populated from each op in the "operations" package
 // into the global table.
@@ -35,7 +51,8 @@ type OperationDescription struct {
 	OperationType OperationType
 	InputType     CoordType
 	OutputType    CoordType
-	creatorFunc   interface{} // for now, this will always be a ConvertLPToXYCreatorFuncType
+	NeedEllps     bool        // false for operations which work without an ellipsoid
+	creatorFunc   interface{} // one of the Convert...CreatorFuncTypes, matching the input and output types
 }
 
 // RegisterConvertLPToXY adds an OperationDescription entry to the OperationDescriptionTable
@@ -47,14 +64,74 @@ func RegisterConvertLPToXY(
 	description string,
 	description2 string,
 	creatorFunc ConvertLPToXYCreatorFuncType,
+) {
+	registerConversion(id, description, description2, CoordTypeLP, CoordTypeXY, true, creatorFunc)
+}
+
+// RegisterConvertLPZToXYZ adds an OperationDescription entry for a conversion
+// from geodetic coordinates with heights to 3D cartesian coordinates
+func RegisterConvertLPZToXYZ(
+	id string,
+	description string,
+	description2 string,
+	creatorFunc ConvertLPZToXYZCreatorFuncType,
+) {
+	registerConversion(id, description, description2, CoordTypeLPZ, CoordTypeXYZ, true, creatorFunc)
+}
+
+// RegisterConvertXYZToENU adds an OperationDescription entry for a conversion
+// from 3D cartesian coordinates to a local east-north-up frame
+func RegisterConvertXYZToENU(
+	id string,
+	description string,
+	description2 string,
+	creatorFunc ConvertXYZToENUCreatorFuncType,
+) {
+	registerConversion(id, description, description2, CoordTypeXYZ, CoordTypeENU, true, creatorFunc)
+}
+
+// RegisterConvertLPZToLPZ adds an OperationDescription entry for a conversion
+// from geodetic coordinates with heights to geodetic coordinates with heights.
+// Some of these, like plain offsets, don't depend on the shape of the earth:
+// they pass needEllps as false, and get WGS84 if no ellipsoid is given.
+func RegisterConvertLPZToLPZ(
+	id string,
+	description string,
+	description2 string,
+	needEllps bool,
+	creatorFunc ConvertLPZToLPZCreatorFuncType,
+) {
+	registerConversion(id, description, description2, CoordTypeLPZ, CoordTypeLPZ, needEllps, creatorFunc)
+}
+
+// RegisterConvertXYZTToXYZT adds an OperationDescription entry for a conversion
+// between generic 4D coordinates. These operations don't need an ellipsoid.
+func RegisterConvertXYZTToXYZT(
+	id string,
+	description string,
+	description2 string,
+	creatorFunc ConvertXYZTToXYZTCreatorFuncType,
+) {
+	registerConversion(id, description, description2, CoordTypeXYZT, CoordTypeXYZT, false, creatorFunc)
+}
+
+func registerConversion(
+	id string,
+	description string,
+	description2 string,
+	inputType CoordType,
+	outputType CoordType,
+	needEllps bool,
+	creatorFunc interface{},
 ) {
 	pi := &OperationDescription{
 		ID:            id,
 		Description:   description,
 		Description2:  description2,
 		OperationType: OperationTypeConversion,
-		InputType:     CoordTypeLP,
-		OutputType:    CoordTypeXY,
+		InputType:     inputType,
+		OutputType:    outputType,
+		NeedEllps:     needEllps,
 		creatorFunc:   creatorFunc,
 	}
 
@@ -71,6 +148,18 @@ func (desc *OperationDescription) CreateOperation(sys *System) (IOperation, erro
 	if desc.IsConvertLPToXY() {
 		return NewConvertLPToXY(sys, desc)
 	}
+	if desc.IsConvertLPZToXYZ() {
+		return NewConvertLPZToXYZ(sys, desc)
+	}
+	if desc.IsConvertXYZToENU() {
+		return NewConvertXYZToENU(sys, desc)
+	}
+	if desc.IsConvertLPZToLPZ() {
+		return NewConvertLPZToLPZ(sys, desc)
+	}
+	if desc.IsConvertXYZTToXYZT() {
+		return NewConvertXYZTToXYZT(sys, desc)
+	}
 
 	return nil, merror.New(merror.NotYetSupported)
 }
@@ -81,3 +170,31 @@ func (desc *OperationDescription) IsConvertLPToXY() bool {
 		desc.InputType == CoordTypeLP &&
 		desc.OutputType == CoordTypeXY
 }
+
+// IsConvertLPZToXYZ returns true iff the operation can be cast to an IConvertLPZToXYZ
+func (desc *OperationDescription) IsConvertLPZToXYZ() bool {
+	return desc.OperationType == OperationTypeConversion &&
+		desc.InputType == CoordTypeLPZ &&
+		desc.OutputType == CoordTypeXYZ
+}
+
+// IsConvertXYZToENU returns true iff the operation can be cast to an IConvertXYZToENU
+func (desc *OperationDescription) IsConvertXYZToENU() bool {
+	return desc.OperationType == OperationTypeConversion &&
+		desc.InputType == CoordTypeXYZ &&
+		desc.OutputType == CoordTypeENU
+}
+
+// IsConvertLPZToLPZ returns true iff the operation can be cast to an IConvertLPZToLPZ
+func (desc *OperationDescription) IsConvertLPZToLPZ() bool {
+	return desc.OperationType == OperationTypeConversion &&
+		desc.InputType == CoordTypeLPZ &&
+		desc.OutputType == CoordTypeLPZ
+}
+
+// IsConvertXYZTToXYZT returns true iff the operation can be cast to an IConvertXYZTToXYZT
+func (desc *OperationDescription) IsConvertXYZTToXYZT() bool {
+	return desc.OperationType == OperationTypeConversion &&
+		desc.InputType == CoordTypeXYZT &&
+		desc.OutputType == CoordTypeXYZT
+}
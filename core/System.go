@@ -209,6 +209,7 @@ func (sys *System) initialize() error {
 	}
 
 	sys.OpDescr = opDescr
+	sys.NeedEllps = opDescr.NeedEllps
 
 	err := sys.processDatum()
 	if err != nil {
@@ -322,7 +323,10 @@ func (sys *System) processEllipsoid() error {
 
 	ellipsoid, err := NewEllipsoid(sys)
 	if err != nil {
-		return err
+		if sys.NeedEllps {
+			return err
+		}
+		ellipsoid = nil
 	}
 
 	if ellipsoid == nil {
@@ -18,6 +18,10 @@ import (
 	"github.com/go-spatial/proj/support"
 )
 
+// errDeltaFailed is returned when the operation succeeds but its output
+// is not within tolerance of the expected value
+var errDeltaFailed = fmt.Errorf("delta failed")
+
 // the 4 input (or output) values, although
 // we only support 2D (a,b) right now
 type coord struct {
@@ -256,6 +260,15 @@ func (c *Command) Execute() error {
 			}
 		}
 
+		if tc.expect.a == math.MaxFloat64 {
+			// this testcase is expected to fail, and only an error from
+			// the operation itself counts: a value, however wrong, does not
+			if err == nil || err == errDeltaFailed {
+				return fmt.Errorf("expected failure")
+			}
+			continue
+		}
+
 		if err != nil {
 			return err
 		}
@@ -276,10 +289,13 @@ func (c *Command) executeForwardOnce(
 	}
 
 	x, y := output.X, output.Y
+	if op.GetSystem().Right == core.IOUnitsAngular {
+		x, y = support.RToDD(x), support.RToDD(y)
+	}
 	ok1 := check(out1, x, c.tolerance)
 	ok2 := check(out2, y, c.tolerance)
 	if !ok1 || !ok2 {
-		return 0, 0, fmt.Errorf("delta failed")
+		return 0, 0, errDeltaFailed
 	}
 
 	return x, y, nil
@@ -291,6 +307,9 @@ func (c *Command) executeInverseOnce(
 	tolerance float64) (float64, float64, error) {
 
 	input := &core.CoordXY{X: in1, Y: in2}
+	if op.GetSystem().Right == core.IOUnitsAngular {
+		input.X, input.Y = support.DDToR(in1), support.DDToR(in2)
+	}
 	output, err := op.Inverse(input)
 	if err != nil {
 		return 0, 0, err
@@ -300,7 +319,7 @@ func (c *Command) executeInverseOnce(
 	ok1 := check(out1, lam, c.tolerance)
 	ok2 := check(out2, phi, c.tolerance)
 	if !ok1 || !ok2 {
-		return 0, 0, fmt.Errorf("delta failed")
+		return 0, 0, errDeltaFailed
 	}
 
 	return lam, phi, nil
@@ -26,6 +26,38 @@ var supportedProjections = []string{
 	"airy",
 	"august",
 	"eqc",
+	"sinu", "eck6",
+	"moll",
+	"eck1", "eck2", "eck3", "eck4", "eck5",
+	"robin",
+	"natearth", "natearth2",
+	"aitoff", "wintri",
+	"hammer",
+	"wag1", "wag2", "wag3", "wag4", "wag5", "wag6", "wag7",
+	"kav7",
+	"omerc", "somerc",
+	"krovak",
+	"poly", "cass", "bonne",
+	"cea", "mill", "gall", "cc", "tcea", "tcc",
+	"eqdc", "ccon", "imw_p",
+	"euler", "murd1", "murd2", "murd3", "pconic", "tissot", "vitk1",
+	"geos",
+	"healpix", "rhealpix",
+	"qsc",
+	"goode", "igh",
+	"ob_tran",
+	"nzmg", "mil_os", "lee_os", "gs48", "alsk", "gs50",
+	"tpeqd", "chamb",
+	"vandg", "vandg2", "vandg3", "vandg4", "larr", "lask", "loxim",
+	"apian", "bacon", "ortel", "nicol", "lagrng",
+	"lsat", "misrsom",
+	"patterson",
+	"putp1", "putp2", "putp3", "putp3p", "putp4p", "weren", "putp5", "putp5p", "putp6", "putp6p",
+	"kav5", "qua_aut", "fouc", "fouc_s", "mbt_s", "mbt_fps", "mbtfpp", "mbtfpq", "mbtfps",
+	"crast", "hatano", "urm5", "urmfps", "fahey", "boggs",
+	"calcofi", "labrd", "bipc",
+	"isea",
+	"latlong", "longlat", "lonlat", "latlon", "geoc",
 }
 
 // If the proj string has one of these keys, we won't execute the Command.
@@ -39,6 +71,11 @@ var unsupportedKeys = []string{
 // Command -- this acts as a way to shut off tests we don't like.
 var skippedTests = []string{
 	"ellipsoid.gie:64",
+	"4D-API_cs2cs-style.gie:44",  // needs the towgs84 datum shift
+	"4D-API_cs2cs-style.gie:55",  // needs the nadgrids datum shift
+	"4D-API_cs2cs-style.gie:168", // needs the towgs84 datum shift
+	"DHDN_ETRS89.gie:4",          // needs the nadgrids datum shift
+	"DHDN_ETRS89.gie:83",         // needs the towgs84 datum shift
 }
 
 // Gie is the top-level object for the Gie test runner
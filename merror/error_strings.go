@@ -40,4 +40,32 @@ var (
 	AeaProjString                   = "invalid projection string for aea"
 	LatTSLargerThan90               = "lat ts is greater than 90"
 	Phi2                            = "invalid phi2 computation"
+	NonConvergent                   = "non-convergent computation"
+	InvalidMOrN                     = "invalid m or n"
+	WOrMZeroOrLess                  = "W or M is zero or less"
+	NOutOfRange                     = "n is out of range"
+	Lat0OrAlphaEq90                 = "lat_0 or alpha is equal to 90"
+	Lat1IsZero                      = "lat_1 is zero"
+	Lat1OrLat2Missing               = "lat_1 or lat_2 is missing"
+	InvalidLat1OrLat2               = "lat_1 and lat_2 are equal or opposite"
+	HLessThanZero                   = "h is less than or equal to zero"
+	InvalidSweepAxis                = "invalid sweep axis, must be x or y"
+	PointNotVisible                 = "point is not visible from the satellite"
+	InvalidSquare                   = "north_square and south_square must be 0, 1, 2 or 3"
+	InvalidOrder                    = "HEALPix order must be between 0 and 29"
+	NoRotationProj                  = "no rotation projection (o_proj) given"
+	Lat1OrLat2ZeroOr90              = "lat_1 or lat_2 is zero or 90"
+	ControlPointNoDist              = "control points are coincident"
+	LsatNotInRange                  = "lsat must be between 1 and 5"
+	PathNotInRange                  = "path is out of range"
+	Lat0IsZero                      = "lat_0 is zero"
+	InvalidOrient                   = "orient must be isea or pole"
+	InvalidMode                     = "only the plane mode is supported"
+	InvalidShape                    = "shape must be square, diamond or nhemisphere"
+	OriginMissing                   = "X_0, Y_0, Z_0 or lon_0, lat_0 are missing"
+	OriginMutuallyExclusive         = "X_0, Y_0, Z_0 and lon_0, lat_0, h_0 are mutually exclusive"
+	NotInvertible                   = "transformation is not invertible"
+	DegMissing                      = "deg is missing"
+	MalformedCoefficients           = "missing or malformed coefficients: %s"
+	OutsideRange                    = "coordinate is outside the range of the polynomial"
 )
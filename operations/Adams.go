@@ -0,0 +1,413 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("guyou",
+		"Guyou",
+		"\n\tMisc Sph",
+		NewGuyou,
+	)
+	core.RegisterConvertLPToXY("peirce_q",
+		"Peirce Quincuncial",
+		"\n\tMisc Sph\n\tshape=",
+		NewPeirceQ,
+	)
+	core.RegisterConvertLPToXY("adams_hemi",
+		"Adams Hemisphere in a Square",
+		"\n\tMisc Sph",
+		NewAdamsHemi,
+	)
+	core.RegisterConvertLPToXY("adams_ws1",
+		"Adams World in a Square I",
+		"\n\tMisc Sph",
+		NewAdamsWs1,
+	)
+	core.RegisterConvertLPToXY("adams_ws2",
+		"Adams World in a Square II",
+		"\n\tMisc Sph",
+		NewAdamsWs2,
+	)
+}
+
+type adamsMode int
+
+const (
+	adamsGuyou adamsMode = iota
+	adamsPeirceQ
+	adamsHemi
+	adamsWs1
+	adamsWs2
+)
+
+// the layouts of the Peirce quincuncial projection: the southern
+// hemisphere folded out around the northern one into a diamond, the
+// diamond turned through 45 degrees to give a square, or the northern
+// hemisphere alone
+type peirceShape int
+
+const (
+	peirceSquare peirceShape = iota
+	peirceDiamond
+	peirceNHemisphere
+)
+
+const adamsTol = 1e-9
+const adamsRSqrt2 = 0.7071067811865475244008443620
+
+// Adams implements core.IOperation and core.ConvertLPToXY
+//
+// This is the family of conformal projections built on the elliptic
+// integral F(phi|1/2): Guyou, Peirce quincuncial, Adams hemisphere in a
+// square and Adams world in a square I and II. Guyou and Peirce
+// quincuncial fold the other hemisphere out across the edges of the
+// square, so that they cover the world.
+type Adams struct {
+	core.Operation
+	mode  adamsMode
+	shape peirceShape
+
+	// F(pi/2|1/2), the half width of the square
+	K float64
+}
+
+// NewGuyou returns a new Guyou
+func NewGuyou(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newAdams(system, adamsGuyou)
+}
+
+// NewPeirceQ returns a new Peirce Quincuncial
+func NewPeirceQ(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newAdams(system, adamsPeirceQ)
+}
+
+// NewAdamsHemi returns a new Adams Hemisphere in a Square
+func NewAdamsHemi(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newAdams(system, adamsHemi)
+}
+
+// NewAdamsWs1 returns a new Adams World in a Square I
+func NewAdamsWs1(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newAdams(system, adamsWs1)
+}
+
+// NewAdamsWs2 returns a new Adams World in a Square II
+func NewAdamsWs2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newAdams(system, adamsWs2)
+}
+
+func newAdams(system *core.System, mode adamsMode) (core.IConvertLPToXY, error) {
+	op := &Adams{
+		mode: mode,
+	}
+	op.System = system
+
+	err := op.setup()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Adams) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	var a, b float64
+	var sm, sn bool
+
+	// which side of the square the other hemisphere is folded out
+	// across, or zero for none
+	var foldX, foldY float64
+
+	switch op.mode {
+	case adamsGuyou:
+		if math.Abs(lp.Lam)-adamsTol > support.PiOverTwo {
+			if lp.Lam < 0. {
+				lp.Lam = -math.Pi - lp.Lam
+				foldX = -1.
+			} else {
+				lp.Lam = math.Pi - lp.Lam
+				foldX = 1.
+			}
+		}
+
+		if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < adamsTol {
+			xy.X = 0.
+			if lp.Phi < 0 {
+				xy.Y = -1.85407
+			} else {
+				xy.Y = 1.85407
+			}
+			return op.fold(xy, foldX, foldY), nil
+		}
+
+		sl := math.Sin(lp.Lam)
+		sp := math.Sin(lp.Phi)
+		cp := math.Cos(lp.Phi)
+		a = support.Aacos((cp*sl - sp) * adamsRSqrt2)
+		b = support.Aacos((cp*sl + sp) * adamsRSqrt2)
+		sm = lp.Lam < 0.
+		sn = lp.Phi < 0.
+
+	case adamsPeirceQ:
+		if lp.Phi < -adamsTol {
+			if op.shape == peirceNHemisphere {
+				return nil, merror.New(merror.LatOrLonExceededLimit)
+			}
+			lp.Phi = -lp.Phi
+			switch {
+			case math.Abs(lp.Lam) < support.PiOverFour:
+				foldY = -1.
+			case math.Abs(lp.Lam) > 3.*support.PiOverFour:
+				foldY = 1.
+			case lp.Lam > 0.:
+				foldX = 1.
+			default:
+				foldX = -1.
+			}
+		}
+
+		sl := math.Sin(lp.Lam)
+		cl := math.Cos(lp.Lam)
+		cp := math.Cos(lp.Phi)
+		a = support.Aacos(cp * (sl + cl) * adamsRSqrt2)
+		b = support.Aacos(cp * (sl - cl) * adamsRSqrt2)
+		sm = sl < 0.
+		sn = cl > 0.
+
+	case adamsHemi:
+		if math.Abs(lp.Lam)-adamsTol > support.PiOverTwo {
+			return nil, merror.New(merror.LatOrLonExceededLimit)
+		}
+		sp := math.Sin(lp.Phi)
+		a = math.Cos(lp.Phi) * math.Sin(lp.Lam)
+		sm = (sp + a) < 0.
+		sn = (sp - a) < 0.
+		a = support.Aacos(a)
+		b = support.PiOverTwo - lp.Phi
+
+	case adamsWs1:
+		sp := math.Tan(0.5 * lp.Phi)
+		b = math.Cos(support.Aasin(sp)) * math.Sin(0.5*lp.Lam)
+		a = support.Aacos((b - sp) * adamsRSqrt2)
+		b = support.Aacos((b + sp) * adamsRSqrt2)
+		sm = lp.Lam < 0.
+		sn = lp.Phi < 0.
+
+	case adamsWs2:
+		spp := math.Tan(0.5 * lp.Phi)
+		a = math.Cos(support.Aasin(spp)) * math.Sin(0.5*lp.Lam)
+		sm = (spp + a) < 0.
+		sn = (spp - a) < 0.
+		b = support.Aacos(spp)
+		a = support.Aacos(a)
+	}
+
+	m := support.Aasin(math.Sqrt(1. + math.Min(0.0, math.Cos(a+b))))
+	if sm {
+		m = -m
+	}
+
+	n := support.Aasin(math.Sqrt(math.Abs(1. - math.Max(0.0, math.Cos(a-b)))))
+	if sn {
+		n = -n
+	}
+
+	xy.X = support.EllipticFHalf(m)
+	xy.Y = support.EllipticFHalf(n)
+
+	if op.mode == adamsHemi || op.mode == adamsWs2 {
+		// rotate by 45 degrees
+		t := xy.X
+		xy.X = adamsRSqrt2 * (xy.X - xy.Y)
+		xy.Y = adamsRSqrt2 * (t + xy.Y)
+	}
+
+	xy = op.fold(xy, foldX, foldY)
+
+	if op.mode == adamsPeirceQ && op.shape == peirceSquare {
+		t := xy.X
+		xy.X = adamsRSqrt2 * (xy.X - xy.Y)
+		xy.Y = adamsRSqrt2 * (t + xy.Y)
+	}
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Adams) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	x, y := xy.X, xy.Y
+
+	if op.mode == adamsHemi || op.mode == adamsWs2 ||
+		(op.mode == adamsPeirceQ && op.shape == peirceSquare) {
+		// undo the 45 degree rotation
+		t := x
+		x = adamsRSqrt2 * (x + y)
+		y = adamsRSqrt2 * (y - t)
+	}
+
+	// bring points on the folded out hemisphere back into the square
+	var foldX, foldY float64
+	switch op.mode {
+	case adamsGuyou:
+		if math.Abs(x) > op.K {
+			foldX = math.Copysign(1., x)
+			x = foldX*2.*op.K - x
+		}
+	case adamsPeirceQ:
+		if op.shape != peirceNHemisphere && math.Abs(x)+math.Abs(y) > 2.*op.K*(1.+adamsTol) {
+			return nil, merror.New(merror.InvalidXOrY)
+		}
+		switch {
+		case op.shape == peirceNHemisphere:
+		case math.Abs(x) > op.K:
+			foldX = math.Copysign(1., x)
+			x = foldX*2.*op.K - x
+		case math.Abs(y) > op.K:
+			foldY = math.Copysign(1., y)
+			y = foldY*2.*op.K - y
+		}
+	}
+
+	m, err := op.amplitude(x)
+	if err != nil {
+		return nil, err
+	}
+	n, err := op.amplitude(y)
+	if err != nil {
+		return nil, err
+	}
+
+	// The forward maps the point to (u, v) in the unit disk, and takes
+	// cos(a) and cos(b) as (u-v)/sqrt(2) and (u+v)/sqrt(2). Then
+	// cos(a+b) = -cos^2(m) and cos(a-b) = cos^2(n), which gives u and
+	// v back.
+	cm, cn := math.Cos(m), math.Cos(n)
+	u := math.Sin(m) * math.Sqrt((1.+cn*cn)/2.)
+	v := math.Sin(n) * math.Sqrt((1.+cm*cm)/2.)
+	if u*u+v*v > 1.+adamsTol {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+
+	switch op.mode {
+	case adamsGuyou:
+		// u = cos(phi) sin(lam), v = sin(phi)
+		lp.Phi = support.Aasin(v)
+		if cp := math.Cos(lp.Phi); cp > adamsTol {
+			lp.Lam = support.Aasin(u / cp)
+		}
+		if foldX != 0. {
+			lp.Lam = foldX*math.Pi - lp.Lam
+		}
+
+	case adamsPeirceQ:
+		// u = cos(phi) sin(lam), v = -cos(phi) cos(lam)
+		cp := math.Hypot(u, v)
+		lp.Phi = support.Aacos(cp)
+		if cp > adamsTol {
+			lp.Lam = math.Atan2(u, -v)
+		}
+		if foldX != 0. || foldY != 0. {
+			lp.Phi = -lp.Phi
+		}
+
+	case adamsHemi:
+		// u - v = sqrt(2) cos(phi) sin(lam), u + v = sqrt(2) sin(phi)
+		lp.Phi = support.Aasin(adamsRSqrt2 * (u + v))
+		if cp := math.Cos(lp.Phi); cp > adamsTol {
+			lp.Lam = support.Aasin(adamsRSqrt2 * (u - v) / cp)
+		}
+
+	case adamsWs1:
+		// u = cos(asin(t)) sin(lam/2), v = t = tan(phi/2)
+		lp.Phi = 2. * math.Atan(v)
+		if ct := math.Sqrt(1. - v*v); ct > adamsTol {
+			lp.Lam = 2. * support.Aasin(u/ct)
+		}
+
+	case adamsWs2:
+		// u - v = sqrt(2) cos(asin(t)) sin(lam/2), u + v = sqrt(2) t
+		t := adamsRSqrt2 * (u + v)
+		lp.Phi = 2. * math.Atan(t)
+		if ct := math.Sqrt(1. - t*t); ct > adamsTol {
+			lp.Lam = 2. * support.Aasin(adamsRSqrt2*(u-v)/ct)
+		}
+	}
+
+	return lp, nil
+}
+
+// fold reflects xy across the side of the square given by foldX or
+// foldY, taking it onto the folded out hemisphere
+func (op *Adams) fold(xy *core.CoordXY, foldX, foldY float64) *core.CoordXY {
+	if foldX != 0. {
+		xy.X = foldX*2.*op.K - xy.X
+	}
+	if foldY != 0. {
+		xy.Y = foldY*2.*op.K - xy.Y
+	}
+	return xy
+}
+
+// amplitude inverts support.EllipticFHalf, returning phi such that
+// F(phi|1/2) = u
+func (op *Adams) amplitude(u float64) (float64, error) {
+	if math.Abs(u) >= op.K {
+		if math.Abs(u) > op.K*(1.+adamsTol) {
+			return 0., merror.New(merror.InvalidXOrY)
+		}
+		return math.Copysign(support.PiOverTwo, u), nil
+	}
+
+	// the exact amplitude is within 1e-7 of what we want, so polish it
+	// against the series the forward uses
+	phi := support.JacobiAmplitude(u, 0.5)
+	for i := 0; i < 3; i++ {
+		s := math.Sin(phi)
+		phi -= (support.EllipticFHalf(phi) - u) * math.Sqrt(1.-0.5*s*s)
+	}
+	return phi, nil
+}
+
+func (op *Adams) setup() error {
+	ps := op.System.ProjString
+
+	if op.mode == adamsPeirceQ {
+		shape, ok := ps.GetAsString("shape")
+		if ok {
+			switch shape {
+			case "square":
+				op.shape = peirceSquare
+			case "diamond":
+				op.shape = peirceDiamond
+			case "nhemisphere":
+				op.shape = peirceNHemisphere
+			default:
+				return merror.New(merror.InvalidShape)
+			}
+		}
+	}
+
+	op.K = support.EllipticFHalf(support.PiOverTwo)
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return nil
+}
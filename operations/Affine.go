@@ -0,0 +1,125 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"fmt"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertXYZTToXYZT("affine",
+		"Affine transformation",
+		"\n\t",
+		NewAffine,
+	)
+}
+
+// Affine implements core.IOperation and core.ConvertXYZTToXYZT
+//
+//	X' = xoff + s11 X + s12 Y + s13 Z
+//	Y' = yoff + s21 X + s22 Y + s23 Z
+//	Z' = zoff + s31 X + s32 Y + s33 Z
+//	T' = toff + tscale T
+//
+// The matrix defaults to the identity. For 2D use, leave Z at zero.
+type Affine struct {
+	core.Operation
+	xoff, yoff, zoff, toff float64
+	s                      [3][3]float64
+	is                     [3][3]float64
+	tscale                 float64
+	invertible             bool
+}
+
+// NewAffine returns a new Affine
+func NewAffine(system *core.System, desc *core.OperationDescription) (core.IConvertXYZTToXYZT, error) {
+	op := &Affine{}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Affine) Forward(xyzt *core.CoordXYZT) (*core.CoordXYZT, error) {
+	ret := &core.CoordXYZT{}
+
+	ret.X = op.xoff + op.s[0][0]*xyzt.X + op.s[0][1]*xyzt.Y + op.s[0][2]*xyzt.Z
+	ret.Y = op.yoff + op.s[1][0]*xyzt.X + op.s[1][1]*xyzt.Y + op.s[1][2]*xyzt.Z
+	ret.Z = op.zoff + op.s[2][0]*xyzt.X + op.s[2][1]*xyzt.Y + op.s[2][2]*xyzt.Z
+	ret.T = op.toff + op.tscale*xyzt.T
+
+	return ret, nil
+}
+
+// Inverse goes backwards
+func (op *Affine) Inverse(xyzt *core.CoordXYZT) (*core.CoordXYZT, error) {
+	if !op.invertible {
+		return nil, merror.New(merror.NotInvertible)
+	}
+
+	ret := &core.CoordXYZT{}
+
+	x := xyzt.X - op.xoff
+	y := xyzt.Y - op.yoff
+	z := xyzt.Z - op.zoff
+	ret.X = op.is[0][0]*x + op.is[0][1]*y + op.is[0][2]*z
+	ret.Y = op.is[1][0]*x + op.is[1][1]*y + op.is[1][2]*z
+	ret.Z = op.is[2][0]*x + op.is[2][1]*y + op.is[2][2]*z
+	ret.T = (xyzt.T - op.toff) / op.tscale
+
+	return ret, nil
+}
+
+func (op *Affine) setup() {
+	ps := op.System.ProjString
+
+	op.xoff, _ = ps.GetAsFloat("xoff")
+	op.yoff, _ = ps.GetAsFloat("yoff")
+	op.zoff, _ = ps.GetAsFloat("zoff")
+	op.toff, _ = ps.GetAsFloat("toff")
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			f, ok := ps.GetAsFloat(fmt.Sprintf("s%d%d", i+1, j+1))
+			if !ok && i == j {
+				f = 1.0
+			}
+			op.s[i][j] = f
+		}
+	}
+
+	op.tscale = 1.0
+	if ps.ContainsKey("tscale") {
+		op.tscale, _ = ps.GetAsFloat("tscale")
+	}
+
+	s := op.s
+	det := s[0][0]*(s[1][1]*s[2][2]-s[1][2]*s[2][1]) -
+		s[0][1]*(s[1][0]*s[2][2]-s[1][2]*s[2][0]) +
+		s[0][2]*(s[1][0]*s[2][1]-s[1][1]*s[2][0])
+
+	// a singular matrix still goes forwards, but can't come back
+	op.invertible = det != 0.0 && op.tscale != 0.0
+	if !op.invertible {
+		return
+	}
+
+	op.is[0][0] = (s[1][1]*s[2][2] - s[1][2]*s[2][1]) / det
+	op.is[0][1] = -(s[0][1]*s[2][2] - s[0][2]*s[2][1]) / det
+	op.is[0][2] = (s[0][1]*s[1][2] - s[0][2]*s[1][1]) / det
+	op.is[1][0] = -(s[1][0]*s[2][2] - s[1][2]*s[2][0]) / det
+	op.is[1][1] = (s[0][0]*s[2][2] - s[0][2]*s[2][0]) / det
+	op.is[1][2] = -(s[0][0]*s[1][2] - s[0][2]*s[1][0]) / det
+	op.is[2][0] = (s[1][0]*s[2][1] - s[1][1]*s[2][0]) / det
+	op.is[2][1] = -(s[0][0]*s[2][1] - s[0][1]*s[2][0]) / det
+	op.is[2][2] = (s[0][0]*s[1][1] - s[0][1]*s[1][0]) / det
+}
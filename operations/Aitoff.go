@@ -0,0 +1,200 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("aitoff",
+		"Aitoff",
+		"\n\tMisc Sph",
+		NewAitoff,
+	)
+	core.RegisterConvertLPToXY("wintri",
+		"Winkel Tripel",
+		"\n\tMisc Sph\n\tlat_1",
+		NewWintri,
+	)
+}
+
+// Aitoff implements core.IOperation and core.ConvertLPToXY
+type Aitoff struct {
+	core.Operation
+	isWintri bool
+	cosphi1  float64
+}
+
+// NewAitoff returns a new Aitoff
+func NewAitoff(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Aitoff{
+		isWintri: false,
+	}
+	op.System = system
+
+	op.System.Ellipsoid.Es = 0.
+	return op, nil
+}
+
+// NewWintri returns a new Winkel Tripel
+func NewWintri(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Aitoff{
+		isWintri: true,
+	}
+	op.System = system
+
+	err := op.wintriSetup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Aitoff) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	c := 0.5 * lp.Lam
+	d := math.Acos(math.Cos(lp.Phi) * math.Cos(c))
+	if d != 0.0 { /* basic Aitoff */
+		xy.Y = 1. / math.Sin(d)
+		xy.X = 2. * d * math.Cos(lp.Phi) * math.Sin(c) * xy.Y
+		xy.Y *= d * math.Sin(lp.Phi)
+	} else {
+		xy.X = 0.
+		xy.Y = 0.
+	}
+	if op.isWintri {
+		xy.X = (xy.X + lp.Lam*op.cosphi1) * 0.5
+		xy.Y = (xy.Y + lp.Phi) * 0.5
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+//
+// The inverse functions were added to PROJ by Drazen Tutic and Lovro
+// Gradiser, based on "Inverse Aitoff and Winkel Tripel Projections"
+// (Bildirici and Ipbuker), using a Newton-Raphson iteration.
+func (op *Aitoff) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	const maxIter = 10
+	const maxRound = 20
+	const epsilon = 1e-12
+
+	var iter, round int
+	var D, C, f1, f2, f1p, f1l, f2p, f2l, dp, dl, sl, sp, cp, cl, x, y float64
+
+	if math.Abs(xy.X) < epsilon && math.Abs(xy.Y) < epsilon {
+		return lp, nil
+	}
+
+	/* initial values for Newton-Raphson method */
+	lp.Phi = xy.Y
+	lp.Lam = xy.X
+	for {
+		iter = 0
+		for {
+			sl, cl = math.Sincos(lp.Lam * 0.5)
+			sp, cp = math.Sincos(lp.Phi)
+			D = cp * cl
+			C = 1. - D*D
+			D = math.Acos(D) / math.Pow(C, 1.5)
+			f1 = 2. * D * C * cp * sl
+			f2 = D * C * sp
+			f1p = 2. * (sl*cl*sp*cp/C - D*sp*sl)
+			f1l = cp*cp*sl*sl/C + D*cp*cl*sp*sp
+			f2p = sp*sp*cl/C + D*sl*sl*cp
+			f2l = 0.5 * (sp*cp*sl/C - D*sp*cp*cp*sl*cl)
+			if op.isWintri {
+				f1 = 0.5 * (f1 + lp.Lam*op.cosphi1)
+				f2 = 0.5 * (f2 + lp.Phi)
+				f1p *= 0.5
+				f1l = 0.5 * (f1l + op.cosphi1)
+				f2p = 0.5 * (f2p + 1.)
+				f2l *= 0.5
+			}
+			f1 -= xy.X
+			f2 -= xy.Y
+			dp = f1p*f2l - f2p*f1l
+			dl = (f2*f1p - f1*f2p) / dp
+			dp = (f1*f2l - f2*f1l) / dp
+			dl = math.Mod(dl, support.Pi) /* set to interval [-M_PI, M_PI] */
+			lp.Phi -= dp
+			lp.Lam -= dl
+
+			if !((math.Abs(dp) > epsilon || math.Abs(dl) > epsilon) && iter < maxIter) {
+				break
+			}
+			iter++
+		}
+		/* correct if symmetrical solution for Aitoff */
+		if lp.Phi > support.PiOverTwo {
+			lp.Phi -= 2. * (lp.Phi - support.PiOverTwo)
+		}
+		if lp.Phi < -support.PiOverTwo {
+			lp.Phi -= 2. * (lp.Phi + support.PiOverTwo)
+		}
+		/* if pole in Aitoff, return longitude of 0 */
+		if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < epsilon && !op.isWintri {
+			lp.Lam = 0.
+		}
+
+		/* calculate x,y coordinates with solution obtained */
+		C = 0.5 * lp.Lam
+		D = math.Acos(cp * math.Cos(C))
+		if D != 0.0 { /* Aitoff */
+			y = 1. / math.Sin(D)
+			x = 2. * D * cp * math.Sin(C) * y
+			y *= D * sp
+		} else {
+			x = 0.
+			y = 0.
+		}
+		if op.isWintri {
+			x = (x + lp.Lam*op.cosphi1) * 0.5
+			y = (y + lp.Phi) * 0.5
+		}
+
+		/* if too far from given values of x,y, repeat with better approximation of phi,lam */
+		if !((math.Abs(xy.X-x) > epsilon || math.Abs(xy.Y-y) > epsilon) && round < maxRound) {
+			break
+		}
+		round++
+	}
+
+	if iter == maxIter && round == maxRound {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	return lp, nil
+}
+
+func (op *Aitoff) wintriSetup(sys *core.System) error {
+
+	ps := op.System.ProjString
+
+	if ps.ContainsKey("lat_1") {
+		lat1, _ := ps.GetAsFloat("lat_1")
+		op.cosphi1 = math.Cos(support.DDToR(lat1))
+		if op.cosphi1 == 0. {
+			return merror.New(merror.LatTSLargerThan90)
+		}
+	} else { /* 50d28' or phi1=acos(2/pi) */
+		op.cosphi1 = 0.636619772367581343
+	}
+
+	op.System.Ellipsoid.Es = 0.
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("apian",
+		"Apian Globular I",
+		"\n\tMisc Sph, no inv.",
+		NewApian,
+	)
+	core.RegisterConvertLPToXY("ortel",
+		"Ortelius Oval",
+		"\n\tMisc Sph, no inv.",
+		NewOrtel,
+	)
+	core.RegisterConvertLPToXY("bacon",
+		"Bacon Globular",
+		"\n\tMisc Sph, no inv.",
+		NewBacon,
+	)
+}
+
+const baconHlfPi2 = 2.46740110027233965467 // (pi/2)^2
+const baconEps = 1e-10
+
+// Bacon implements core.IOperation and core.ConvertLPToXY
+//
+// This covers the Apian, Ortelius and Bacon globulars.
+type Bacon struct {
+	core.Operation
+	bacn bool
+	ortl bool
+}
+
+// NewApian returns a new Apian Globular I
+func NewApian(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newBacon(system, false, false)
+}
+
+// NewOrtel returns a new Ortelius Oval
+func NewOrtel(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newBacon(system, false, true)
+}
+
+// NewBacon returns a new Bacon
+func NewBacon(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newBacon(system, true, false)
+}
+
+func newBacon(system *core.System, bacn bool, ortl bool) (core.IConvertLPToXY, error) {
+	op := &Bacon{
+		bacn: bacn,
+		ortl: ortl,
+	}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Bacon) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if Q.bacn {
+		xy.Y = support.PiOverTwo * math.Sin(lp.Phi)
+	} else {
+		xy.Y = lp.Phi
+	}
+
+	ax := math.Abs(lp.Lam)
+	if ax >= baconEps {
+		if Q.ortl && ax >= support.PiOverTwo {
+			xy.X = math.Sqrt(baconHlfPi2-lp.Phi*lp.Phi+baconEps) + ax - support.PiOverTwo
+		} else {
+			f := 0.5 * (baconHlfPi2/ax + ax)
+			xy.X = ax - f + math.Sqrt(f*f-xy.Y*xy.Y)
+		}
+		if lp.Lam < 0. {
+			xy.X = -xy.X
+		}
+	} else {
+		xy.X = 0.
+	}
+
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Bacon) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("bertin1953",
+		"Bertin 1953",
+		"\n\tMisc Sph",
+		NewBertin1953,
+	)
+}
+
+const (
+	bertin1953Fu = 1.4
+	bertin1953K  = 12.
+	bertin1953W  = 1.68
+)
+
+// Bertin1953 implements core.IOperation and core.ConvertLPToXY
+//
+// This is a rotated Hammer projection, with some extra compression
+// of the oceans before and after projecting.
+type Bertin1953 struct {
+	core.Operation
+	cosDeltaPhi   float64
+	sinDeltaPhi   float64
+	cosDeltaGamma float64
+	sinDeltaGamma float64
+}
+
+// NewBertin1953 returns a new Bertin1953
+func NewBertin1953(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Bertin1953{}
+	op.System = system
+
+	P := op.System
+	P.Lam0 = 0
+	P.Phi0 = support.DDToR(-42.)
+
+	op.cosDeltaPhi = math.Cos(P.Phi0)
+	op.sinDeltaPhi = math.Sin(P.Phi0)
+	op.cosDeltaGamma = 1.
+	op.sinDeltaGamma = 0.
+
+	P.Ellipsoid.Es = 0.
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Bertin1953) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+
+	// rotate
+	lam := lp.Lam + support.DDToR(-16.5)
+	cosphi := math.Cos(lp.Phi)
+	x := math.Cos(lam) * cosphi
+	y := math.Sin(lam) * cosphi
+	z := math.Sin(lp.Phi)
+	z0 := z*Q.cosDeltaPhi + x*Q.sinDeltaPhi
+	lam = math.Atan2(y*Q.cosDeltaGamma-z0*Q.sinDeltaGamma,
+		x*Q.cosDeltaPhi-z*Q.sinDeltaPhi)
+	z0 = z0*Q.cosDeltaGamma + y*Q.sinDeltaGamma
+
+	rotated := &core.CoordLP{
+		Lam: support.Adjlon(lam),
+		Phi: math.Asin(z0),
+	}
+
+	return bertin1953Project(rotated)
+}
+
+// bertin1953Project projects the rotated coordinates
+func bertin1953Project(lp *core.CoordLP) (*core.CoordXY, error) {
+	lam := lp.Lam
+	phi := lp.Phi
+
+	// adjust pre-projection
+	if lam+phi < -bertin1953Fu {
+		d := (lam - phi + 1.6) * (lam + phi + bertin1953Fu) / 8.
+		lam += d
+		phi -= 0.8 * d * math.Sin(phi+math.Pi/2.)
+	}
+
+	// project with Hammer (1.68,2)
+	cosphi := math.Cos(phi)
+	d := math.Sqrt(2. / (1. + cosphi*math.Cos(lam/2.)))
+	xy := &core.CoordXY{}
+	xy.X = bertin1953W * d * cosphi * math.Sin(lam/2.)
+	xy.Y = d * math.Sin(phi)
+
+	// adjust post-projection
+	d = (1. - math.Cos(lam*phi)) / bertin1953K
+	if xy.Y < 0. {
+		xy.X *= 1. + d
+	}
+	if xy.Y > 0. {
+		xy.X *= 1. + d/1.5*xy.X*xy.X
+	}
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+//
+// There is no closed form, so we start from the inverse of the plain
+// Hammer projection, iterate on the rotated coordinates, and then
+// undo the rotation.
+func (op *Bertin1953) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+
+	// undo the post-projection adjustment, which depends on where
+	// we are, by a few rounds of fixed point iteration
+	hx := xy.X
+	rotated := &core.CoordLP{}
+	for i := 0; i < 5; i++ {
+		rotated.Lam, rotated.Phi = bertin1953InverseHammer(hx, xy.Y)
+		d := (1. - math.Cos(rotated.Lam*rotated.Phi)) / bertin1953K
+		if xy.Y < 0. {
+			hx = xy.X / (1. + d)
+		} else if xy.Y > 0. {
+			hx = xy.X / (1. + d/1.5*hx*hx)
+		}
+	}
+
+	err := genericInverse2D(bertin1953Project, xy, rotated, 1e-12)
+	if err != nil {
+		return nil, err
+	}
+
+	// undo the rotation
+	cosphi := math.Cos(rotated.Phi)
+	x := math.Cos(rotated.Lam) * cosphi
+	y := math.Sin(rotated.Lam) * cosphi
+	z := math.Sin(rotated.Phi)
+	x0 := x*Q.cosDeltaPhi + z*Q.sinDeltaPhi
+	z0 := z*Q.cosDeltaPhi - x*Q.sinDeltaPhi
+
+	lp := &core.CoordLP{
+		Lam: support.Adjlon(math.Atan2(y, x0) - support.DDToR(-16.5)),
+		Phi: support.Aasin(z0),
+	}
+	return lp, nil
+}
+
+// bertin1953InverseHammer is the inverse of Hammer (1.68,2)
+func bertin1953InverseHammer(x, y float64) (float64, float64) {
+	hx := x / bertin1953W
+	rho := math.Hypot(hx, y)
+	if rho == 0 {
+		return 0, 0
+	}
+	c := 2. * support.Aasin(rho/2.)
+	phi := support.Aasin(y * math.Sin(c) / rho)
+	lam := 2. * math.Atan2(hx*math.Sin(c), rho*math.Cos(c))
+	return lam, phi
+}
@@ -0,0 +1,221 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("bipc",
+		"Bipolar conic of western hemisphere",
+		"\n\tConic Sph",
+		NewBipc,
+	)
+}
+
+const bipcEps = 1e-10
+const bipcOneEps = 1.000000001
+const bipcNIter = 10
+const bipcLamB = -.34894976726250681539
+const bipcN = .63055844881274687180
+const bipcF = 1.89724742567461030582
+const bipcAzab = .81650043674686363166
+const bipcAzba = 1.82261843856185925133
+const bipcT = 1.27246578267089012270
+const bipcRhoc = 1.20709121521568721927
+const bipcCAzc = .69691523038678375519
+const bipcSAzc = .71715351331143607555
+const bipcC45 = .70710678118654752469
+const bipcS45 = .70710678118654752410
+const bipcC20 = .93969262078590838411
+const bipcS20 = -.34202014332566873287
+const bipcR110 = 1.91986217719376253360
+const bipcR104 = 1.81514242207410275904
+
+// Bipc implements core.IOperation and core.ConvertLPToXY
+type Bipc struct {
+	core.Operation
+	noskew bool
+}
+
+// NewBipc returns a new Bipc
+func NewBipc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Bipc{}
+	op.System = system
+
+	op.noskew = op.System.ProjString.ContainsKey("ns")
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// bipcClampAcos returns acos(v), tolerating arguments just past unity
+func bipcClampAcos(v float64) (float64, error) {
+	if math.Abs(v) > 1. {
+		if math.Abs(v) > bipcOneEps {
+			return 0., merror.New(merror.ToleranceCondition)
+		}
+		if v < 0. {
+			return math.Pi, nil
+		}
+		return 0., nil
+	}
+	return math.Acos(v), nil
+}
+
+// Forward goes forewards
+func (op *Bipc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	var tphi, Az, z, Av float64
+	var err error
+
+	cphi := math.Cos(lp.Phi)
+	sphi := math.Sin(lp.Phi)
+	sdlam := bipcLamB - lp.Lam
+	cdlam := math.Cos(sdlam)
+	sdlam = math.Sin(sdlam)
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < bipcEps {
+		if lp.Phi < 0. {
+			Az = math.Pi
+		} else {
+			Az = 0.
+		}
+		tphi = math.MaxFloat64
+	} else {
+		tphi = sphi / cphi
+		Az = math.Atan2(sdlam, bipcC45*(tphi-cdlam))
+	}
+
+	tag := Az > bipcAzba
+	if tag {
+		sdlam = lp.Lam + bipcR110
+		cdlam = math.Cos(sdlam)
+		sdlam = math.Sin(sdlam)
+		z, err = bipcClampAcos(bipcS20*sphi + bipcC20*cphi*cdlam)
+		if err != nil {
+			return nil, err
+		}
+		if tphi != math.MaxFloat64 {
+			Az = math.Atan2(sdlam, (bipcC20*tphi - bipcS20*cdlam))
+		}
+		Av = bipcAzab
+		xy.Y = bipcRhoc
+	} else {
+		z, err = bipcClampAcos(bipcS45 * (sphi + cphi*cdlam))
+		if err != nil {
+			return nil, err
+		}
+		Av = bipcAzba
+		xy.Y = -bipcRhoc
+	}
+
+	t := math.Pow(math.Tan(.5*z), bipcN)
+	r := bipcF * t
+	al := .5 * (bipcR104 - z)
+	if al < 0. {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	al, err = bipcClampAcos((t + math.Pow(math.Tan(al), bipcN)) / bipcT)
+	if err != nil {
+		return nil, err
+	}
+
+	t = bipcN * (Av - Az)
+	if math.Abs(t) < al {
+		if tag {
+			r /= math.Cos(al + t)
+		} else {
+			r /= math.Cos(al - t)
+		}
+	}
+	xy.X = r * math.Sin(t)
+	if tag {
+		xy.Y -= r * math.Cos(t)
+	} else {
+		xy.Y += r * math.Cos(t)
+	}
+
+	if op.noskew {
+		t = xy.X
+		xy.X = -xy.X*bipcCAzc - xy.Y*bipcSAzc
+		xy.Y = -xy.Y*bipcCAzc + t*bipcSAzc
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Bipc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	var s, c, Av float64
+
+	if op.noskew {
+		t := xy.X
+		xy.X = -xy.X*bipcCAzc + xy.Y*bipcSAzc
+		xy.Y = -xy.Y*bipcCAzc - t*bipcSAzc
+	}
+
+	neg := xy.X < 0.
+	if neg {
+		xy.Y = bipcRhoc - xy.Y
+		s = bipcS20
+		c = bipcC20
+		Av = bipcAzab
+	} else {
+		xy.Y += bipcRhoc
+		s = bipcS45
+		c = bipcC45
+		Av = bipcAzba
+	}
+
+	r := math.Hypot(xy.X, xy.Y)
+	rp := r
+	rl := r
+	Az := math.Atan2(xy.X, xy.Y)
+	fAz := math.Abs(Az)
+	z := 0.0
+
+	i := bipcNIter
+	for ; i > 0; i-- {
+		z = 2. * math.Atan(math.Pow(r/bipcF, 1/bipcN))
+		al := math.Acos((math.Pow(math.Tan(.5*z), bipcN) +
+			math.Pow(math.Tan(.5*(bipcR104-z)), bipcN)) / bipcT)
+		if fAz < al {
+			if neg {
+				r = rp * math.Cos(al+Az)
+			} else {
+				r = rp * math.Cos(al-Az)
+			}
+		}
+		if math.Abs(rl-r) < bipcEps {
+			break
+		}
+		rl = r
+	}
+	if i == 0 {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	Az = Av - Az/bipcN
+	lp.Phi = math.Asin(s*math.Cos(z) + c*math.Sin(z)*math.Cos(Az))
+	lp.Lam = math.Atan2(math.Sin(Az), c/math.Tan(z)-s*math.Cos(Az))
+	if neg {
+		lp.Lam -= bipcR110
+	} else {
+		lp.Lam = bipcLamB - lp.Lam
+	}
+	return lp, nil
+}
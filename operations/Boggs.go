@@ -0,0 +1,74 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("boggs",
+		"Boggs Eumorphic",
+		"\n\tPCyl, no inv, Sph",
+		NewBoggs,
+	)
+}
+
+const boggsNIter = 20
+const boggsEps = 1e-7
+const boggsFXC = 2.00276
+const boggsFXC2 = 1.11072
+const boggsFYC = 0.49931
+
+// Boggs implements core.IOperation and core.ConvertLPToXY
+type Boggs struct {
+	core.Operation
+}
+
+// NewBoggs returns a new Boggs
+func NewBoggs(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Boggs{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Boggs) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	theta := lp.Phi
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < boggsEps {
+		xy.X = 0.
+	} else {
+		c := math.Sin(theta) * math.Pi
+		for i := boggsNIter; i > 0; i-- {
+			th1 := (theta + math.Sin(theta) - c) / (1. + math.Cos(theta))
+			theta -= th1
+			if math.Abs(th1) < boggsEps {
+				break
+			}
+		}
+		theta *= 0.5
+		xy.X = boggsFXC * lp.Lam / (1./math.Cos(lp.Phi) + boggsFXC2/math.Cos(theta))
+	}
+	xy.Y = boggsFYC * (lp.Phi + math.Sqrt2*math.Sin(theta))
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Boggs) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("bonne",
+		"Bonne (Werner lat_1=90)",
+		"\n\tConic Sph&Ell\n\tlat_1=",
+		NewBonne,
+	)
+}
+
+// Bonne implements core.IOperation and core.ConvertLPToXY
+type Bonne struct {
+	core.Operation
+	isSphere bool
+	phi1     float64
+	cphi1    float64
+	am1      float64
+	m1       float64
+	en       []float64
+}
+
+// NewBonne returns a new Bonne
+func NewBonne(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Bonne{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Bonne) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if op.isSphere {
+		return op.sphericalForward(lp)
+	}
+	return op.ellipsoidalForward(lp)
+}
+
+// Inverse goes backwards
+func (op *Bonne) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if op.isSphere {
+		return op.sphericalInverse(xy)
+	}
+	return op.ellipsoidalInverse(xy)
+}
+
+//---------------------------------------------------------------------
+
+func (op *Bonne) ellipsoidalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	E, c := math.Sincos(lp.Phi)
+	rh := op.am1 + op.m1 - support.Mlfn(lp.Phi, E, c, op.en)
+	E = c * lp.Lam / (rh * math.Sqrt(1.-PE.Es*E*E))
+	xy.X = rh * math.Sin(E)
+	xy.Y = op.am1 - rh*math.Cos(E)
+	return xy, nil
+}
+
+func (op *Bonne) sphericalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Spheroidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	rh := op.cphi1 + op.phi1 - lp.Phi
+	if math.Abs(rh) > eps10 {
+		E := lp.Lam * math.Cos(lp.Phi) / rh
+		xy.X = rh * math.Sin(E)
+		xy.Y = op.cphi1 - rh*math.Cos(E)
+	} else {
+		xy.X = 0.
+		xy.Y = 0.
+	}
+	return xy, nil
+}
+
+func (op *Bonne) ellipsoidalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	PE := op.System.Ellipsoid
+	var err error
+
+	xy.Y = op.am1 - xy.Y
+	rh := math.Hypot(xy.X, xy.Y)
+	lp.Phi, err = support.InvMlfn(op.am1+op.m1-rh, PE.Es, op.en)
+	if err != nil {
+		return nil, err
+	}
+	s := math.Abs(lp.Phi)
+	if s < support.PiOverTwo {
+		s = math.Sin(lp.Phi)
+		lp.Lam = rh * math.Atan2(xy.X, xy.Y) * math.Sqrt(1.-PE.Es*s*s) / math.Cos(lp.Phi)
+	} else if math.Abs(s-support.PiOverTwo) <= eps10 {
+		lp.Lam = 0.
+	} else {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	return lp, nil
+}
+
+func (op *Bonne) sphericalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Spheroidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	xy.Y = op.cphi1 - xy.Y
+	rh := math.Hypot(xy.X, xy.Y)
+	lp.Phi = op.cphi1 + op.phi1 - rh
+	if math.Abs(lp.Phi) > support.PiOverTwo {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) <= eps10 {
+		lp.Lam = 0.
+	} else {
+		lp.Lam = rh * math.Atan2(xy.X, xy.Y) / math.Cos(lp.Phi)
+	}
+	return lp, nil
+}
+
+func (op *Bonne) setup(sys *core.System) error {
+	PE := op.System.Ellipsoid
+
+	lat1, _ := op.System.ProjString.GetAsFloat("lat_1")
+	op.phi1 = support.DDToR(lat1)
+	if math.Abs(op.phi1) < eps10 {
+		return merror.New(merror.Lat1IsZero)
+	}
+
+	if PE.Es != 0.0 {
+		op.isSphere = false
+		op.en = support.Enfn(PE.Es)
+		s, c := math.Sincos(op.phi1)
+		op.m1 = support.Mlfn(op.phi1, s, c, op.en)
+		op.am1 = c / (math.Sqrt(1.-PE.Es*s*s) * s)
+	} else {
+		op.isSphere = true
+		if math.Abs(op.phi1)+eps10 >= support.PiOverTwo {
+			op.cphi1 = 0.
+		} else {
+			op.cphi1 = 1. / math.Tan(op.phi1)
+		}
+	}
+	return nil
+}
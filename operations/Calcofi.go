@@ -0,0 +1,128 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("calcofi",
+		"Cal Coop Ocean Fish Invest Lines/Stations",
+		"\n\tCyl, Sph&Ell",
+		NewCalcofi,
+	)
+}
+
+// CalCOFI coordinates are measured in lines and stations relative to
+// point O, which sits at line 80, station 60 (lon -121.15, lat 34.15),
+// on a grid rotated by 30 degrees.
+const calcofiDegToLine = 5.
+const calcofiDegToStation = 15.
+const calcofiLineToRad = 0.0034906585039886591
+const calcofiStationToRad = 0.0011635528346628864
+const calcofiPtOLine = 80.
+const calcofiPtOStation = 60.
+const calcofiPtOLambda = -2.1144663887911301
+const calcofiPtOPhi = 0.59602993955606354
+const calcofiRotationAngle = 0.5235987755982989
+
+// Calcofi implements core.IOperation and core.ConvertLPToXY
+type Calcofi struct {
+	core.Operation
+}
+
+// NewCalcofi returns a new Calcofi
+func NewCalcofi(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Calcofi{}
+	op.System = system
+
+	P := op.System
+	PE := P.Ellipsoid
+
+	// Ignore any +lon_0, +x_0 or +y_0 so that xy stays consistent with
+	// point O, and stomp on the scaling so that lines and stations are
+	// passed through unchanged.
+	P.Lam0 = 0.
+	P.X0 = 0.
+	P.Y0 = 0.
+	P.Over = true
+	PE.A = 1.
+	PE.Ra = 1.
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Calcofi) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) <= eps10 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+
+	// Mercator transform of the point and of point O
+	xy.X = lp.Lam
+	xy.Y = op.mercatorY(lp.Phi)
+	oy := op.mercatorY(calcofiPtOPhi)
+
+	// l1 and l2 sum to the east/west distance between point O and the
+	// point; r is on the same station as O and the same line as the point
+	l1 := (xy.Y - oy) * math.Tan(calcofiRotationAngle)
+	l2 := -xy.X - l1 + calcofiPtOLambda
+	ry := l2*math.Cos(calcofiRotationAngle)*math.Sin(calcofiRotationAngle) + xy.Y
+	if PE.Es != 0.0 {
+		var err error
+		ry, err = support.Phi2(math.Exp(-ry), PE.E)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ry = support.PiOverTwo - 2.*math.Atan(math.Exp(-ry))
+	}
+
+	xy.X = calcofiPtOLine - support.RToDD(ry-calcofiPtOPhi)*
+		calcofiDegToLine/math.Cos(calcofiRotationAngle)
+	xy.Y = calcofiPtOStation + support.RToDD(ry-lp.Phi)*
+		calcofiDegToStation/math.Sin(calcofiRotationAngle)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Calcofi) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	ry := calcofiPtOPhi - calcofiLineToRad*(xy.X-calcofiPtOLine)*
+		math.Cos(calcofiRotationAngle)
+	lp.Phi = ry - calcofiStationToRad*(xy.Y-calcofiPtOStation)*
+		math.Sin(calcofiRotationAngle)
+
+	oymctr := op.mercatorY(calcofiPtOPhi)
+	rymctr := op.mercatorY(ry)
+	xymctr := op.mercatorY(lp.Phi)
+	l1 := (xymctr - oymctr) * math.Tan(calcofiRotationAngle)
+	l2 := (rymctr - xymctr) / (math.Cos(calcofiRotationAngle) * math.Sin(calcofiRotationAngle))
+	lp.Lam = calcofiPtOLambda - (l1 + l2)
+	return lp, nil
+}
+
+// mercatorY returns the Mercator ordinate of phi, on the unit sphere
+// or ellipsoid
+func (op *Calcofi) mercatorY(phi float64) float64 {
+	PE := op.System.Ellipsoid
+	if PE.Es != 0.0 {
+		return -math.Log(support.Tsfn(phi, math.Sin(phi), PE.E))
+	}
+	return math.Log(math.Tan(support.PiOverFour + .5*phi))
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPZToXYZ("cart",
+		"Geodetic/cartesian conversions",
+		"\n\t",
+		NewCart,
+	)
+}
+
+// Cart implements core.IOperation and core.ConvertLPZToXYZ
+//
+// It converts geodetic coordinates (with ellipsoidal heights) to
+// geocentric cartesian (ECEF) coordinates, and back.
+type Cart struct {
+	core.Operation
+}
+
+// NewCart returns a new Cart
+func NewCart(system *core.System, desc *core.OperationDescription) (core.IConvertLPZToXYZ, error) {
+	op := &Cart{}
+	op.System = system
+
+	P := op.System
+	P.Left = core.IOUnitsAngular
+	P.Right = core.IOUnitsCartesian
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Cart) Forward(lpz *core.CoordLPZ) (*core.CoordXYZ, error) {
+	return cartesian(op.System.Ellipsoid, lpz), nil
+}
+
+// Inverse goes backwards
+func (op *Cart) Inverse(xyz *core.CoordXYZ) (*core.CoordLPZ, error) {
+	return geodetic(op.System.Ellipsoid, xyz), nil
+}
+
+// normalRadiusOfCurvature returns the radius of curvature in the prime vertical
+func normalRadiusOfCurvature(PE *core.Ellipsoid, sinphi float64) float64 {
+	if PE.Es == 0.0 {
+		return PE.A
+	}
+	return PE.A / math.Sqrt(1.-PE.Es*sinphi*sinphi)
+}
+
+// cartesian converts geodetic coordinates to geocentric cartesian coordinates
+func cartesian(PE *core.Ellipsoid, lpz *core.CoordLPZ) *core.CoordXYZ {
+	cosphi := math.Cos(lpz.Phi)
+	sinphi := math.Sin(lpz.Phi)
+	N := normalRadiusOfCurvature(PE, sinphi)
+
+	return &core.CoordXYZ{
+		X: (N + lpz.Z) * cosphi * math.Cos(lpz.Lam),
+		Y: (N + lpz.Z) * cosphi * math.Sin(lpz.Lam),
+		Z: (N*(1.-PE.Es) + lpz.Z) * sinphi,
+	}
+}
+
+// geodetic converts geocentric cartesian coordinates to geodetic coordinates,
+// using Bowring's closed form
+func geodetic(PE *core.Ellipsoid, xyz *core.CoordXYZ) *core.CoordLPZ {
+	lpz := &core.CoordLPZ{}
+
+	p := math.Hypot(xyz.X, xyz.Y)
+	theta := math.Atan2(xyz.Z*PE.A, p*PE.B)
+	c := math.Cos(theta)
+	s := math.Sin(theta)
+
+	lpz.Phi = math.Atan2(xyz.Z+PE.E2s*PE.B*s*s*s, p-PE.Es*PE.A*c*c*c)
+	if math.Abs(lpz.Phi) > support.PiOverTwo {
+		lpz.Phi = math.Copysign(support.PiOverTwo, lpz.Phi)
+	}
+	lpz.Lam = math.Atan2(xyz.Y, xyz.X)
+
+	cosphi := math.Cos(lpz.Phi)
+	N := normalRadiusOfCurvature(PE, math.Sin(lpz.Phi))
+
+	if math.Abs(cosphi) < 1e-6 {
+		/* poleward of 89.99994 deg, we avoid division by zero */
+		/* by computing the height as the cartesian z value    */
+		/* minus the semiminor axis length                     */
+		lpz.Z = math.Abs(xyz.Z) - PE.B
+	} else {
+		lpz.Z = p/cosphi - N
+	}
+
+	return lpz
+}
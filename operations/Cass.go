@@ -0,0 +1,142 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("cass",
+		"Cassini",
+		"\n\tCyl, Sph&Ell",
+		NewCass,
+	)
+}
+
+const (
+	cassC1 = .16666666666666666666
+	cassC2 = .00833333333333333333
+	cassC3 = .04166666666666666666
+	cassC4 = .33333333333333333333
+	cassC5 = .06666666666666666666
+)
+
+// Cass implements core.IOperation and core.ConvertLPToXY
+type Cass struct {
+	core.Operation
+	isSphere bool
+	m0       float64
+	en       []float64
+}
+
+// NewCass returns a new Cass
+func NewCass(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Cass{}
+	op.System = system
+
+	op.setup(system)
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Cass) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if op.isSphere {
+		return op.sphericalForward(lp)
+	}
+	return op.ellipsoidalForward(lp)
+}
+
+// Inverse goes backwards
+func (op *Cass) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if op.isSphere {
+		return op.sphericalInverse(xy)
+	}
+	return op.ellipsoidalInverse(xy)
+}
+
+//---------------------------------------------------------------------
+
+func (op *Cass) ellipsoidalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	n, c := math.Sincos(lp.Phi)
+	xy.Y = support.Mlfn(lp.Phi, n, c, op.en)
+	n = 1. / math.Sqrt(1.-PE.Es*n*n)
+	tn := math.Tan(lp.Phi)
+	t := tn * tn
+	a1 := lp.Lam * c
+	c *= PE.Es * c / (1 - PE.Es)
+	a2 := a1 * a1
+	xy.X = n * a1 * (1. - a2*t*(cassC1-(8.-t+8.*c)*a2*cassC2))
+	xy.Y -= op.m0 - n*tn*a2*(.5+(5.-t+6.*c)*a2*cassC3)
+	return xy, nil
+}
+
+func (op *Cass) sphericalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Spheroidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	P := op.System
+
+	xy.X = math.Asin(math.Cos(lp.Phi) * math.Sin(lp.Lam))
+	xy.Y = math.Atan2(math.Tan(lp.Phi), math.Cos(lp.Lam)) - P.Phi0
+	return xy, nil
+}
+
+func (op *Cass) ellipsoidalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	ph1, err := support.InvMlfn(op.m0+xy.Y, PE.Es, op.en)
+	if err != nil {
+		return nil, err
+	}
+	tn := math.Tan(ph1)
+	t := tn * tn
+	n := math.Sin(ph1)
+	r := 1. / (1. - PE.Es*n*n)
+	n = math.Sqrt(r)
+	r *= (1. - PE.Es) * n
+	dd := xy.X / n
+	d2 := dd * dd
+	lp.Phi = ph1 - (n*tn/r)*d2*(.5-(1.+3.*t)*d2*cassC3)
+	lp.Lam = dd * (1. + t*d2*(-cassC4+(1.+3.*t)*d2*cassC5)) / math.Cos(ph1)
+	return lp, nil
+}
+
+func (op *Cass) sphericalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Spheroidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	P := op.System
+
+	dd := xy.Y + P.Phi0
+	lp.Phi = math.Asin(math.Sin(dd) * math.Cos(xy.X))
+	lp.Lam = math.Atan2(math.Tan(xy.X), math.Cos(dd))
+	return lp, nil
+}
+
+func (op *Cass) setup(sys *core.System) {
+	P := op.System
+	PE := op.System.Ellipsoid
+
+	if PE.Es != 0.0 {
+		op.isSphere = false
+		op.en = support.Enfn(PE.Es)
+		op.m0 = support.Mlfn(P.Phi0, math.Sin(P.Phi0), math.Cos(P.Phi0), op.en)
+	} else {
+		op.isSphere = true
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("cc",
+		"Central Cylindrical",
+		"\n\tCyl, Sph",
+		NewCc,
+	)
+}
+
+// Cc implements core.IOperation and core.ConvertLPToXY
+type Cc struct {
+	core.Operation
+}
+
+// NewCc returns a new Cc
+func NewCc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Cc{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Cc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) <= eps10 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	xy.X = lp.Lam
+	xy.Y = math.Tan(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Cc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = math.Atan(xy.Y)
+	lp.Lam = xy.X
+	return lp, nil
+}
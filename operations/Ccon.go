@@ -0,0 +1,84 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("ccon",
+		"Central Conic",
+		"\n\tCentral Conic, Sph\n\tlat_1=",
+		NewCcon,
+	)
+}
+
+// Ccon implements core.IOperation and core.ConvertLPToXY
+type Ccon struct {
+	core.Operation
+	phi1    float64
+	ctgphi1 float64
+	sinphi1 float64
+	cosphi1 float64
+}
+
+// NewCcon returns a new Ccon
+func NewCcon(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Ccon{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Ccon) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+
+	r := Q.ctgphi1 - math.Tan(lp.Phi-Q.phi1)
+	xy.X = r * math.Sin(lp.Lam*Q.sinphi1)
+	xy.Y = Q.ctgphi1 - r*math.Cos(lp.Lam*Q.sinphi1)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Ccon) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+
+	xy.Y = Q.ctgphi1 - xy.Y
+	lp.Phi = Q.phi1 - math.Atan(math.Hypot(xy.X, xy.Y)-Q.ctgphi1)
+	lp.Lam = math.Atan2(xy.X, xy.Y) / Q.sinphi1
+	return lp, nil
+}
+
+func (op *Ccon) setup(sys *core.System) error {
+	Q := op
+	P := op.System
+
+	lat1, _ := P.ProjString.GetAsFloat("lat_1")
+	Q.phi1 = support.DDToR(lat1)
+	if math.Abs(Q.phi1) < eps10 {
+		return merror.New(merror.Lat1IsZero)
+	}
+
+	Q.sinphi1, Q.cosphi1 = math.Sincos(Q.phi1)
+	Q.ctgphi1 = Q.cosphi1 / Q.sinphi1
+
+	P.Ellipsoid.Es = 0.0
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("cea",
+		"Equal Area Cylindrical",
+		"\n\tCyl, Sph&Ell\n\tlat_ts=",
+		NewCea,
+	)
+}
+
+const ceaEps = 1e-10
+
+// Cea implements core.IOperation and core.ConvertLPToXY
+type Cea struct {
+	core.Operation
+	isSphere bool
+	qp       float64
+	apa      []float64
+}
+
+// NewCea returns a new Cea
+func NewCea(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Cea{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Cea) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if op.isSphere {
+		return op.sphericalForward(lp)
+	}
+	return op.ellipsoidalForward(lp)
+}
+
+// Inverse goes backwards
+func (op *Cea) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if op.isSphere {
+		return op.sphericalInverse(xy)
+	}
+	return op.ellipsoidalInverse(xy)
+}
+
+//---------------------------------------------------------------------
+
+func (op *Cea) ellipsoidalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	P := op.System
+	PE := op.System.Ellipsoid
+
+	xy.X = P.K0 * lp.Lam
+	xy.Y = 0.5 * support.Qsfn(math.Sin(lp.Phi), PE.E, PE.OneEs) / P.K0
+	return xy, nil
+}
+
+func (op *Cea) sphericalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Spheroidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	P := op.System
+
+	xy.X = P.K0 * lp.Lam
+	xy.Y = math.Sin(lp.Phi) / P.K0
+	return xy, nil
+}
+
+func (op *Cea) ellipsoidalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	P := op.System
+
+	lp.Phi = support.Authlat(math.Asin(2.*xy.Y*P.K0/op.qp), op.apa)
+	lp.Lam = xy.X / P.K0
+	return lp, nil
+}
+
+func (op *Cea) sphericalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Spheroidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	P := op.System
+
+	xy.Y *= P.K0
+	t := math.Abs(xy.Y)
+	if t-ceaEps > 1. {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	if t >= 1. {
+		if xy.Y < 0. {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+	} else {
+		lp.Phi = math.Asin(xy.Y)
+	}
+	lp.Lam = xy.X / P.K0
+	return lp, nil
+}
+
+func (op *Cea) setup(sys *core.System) error {
+	P := op.System
+	PE := op.System.Ellipsoid
+	ps := op.System.ProjString
+
+	t := 0.0
+	if ps.ContainsKey("lat_ts") {
+		t, _ = ps.GetAsFloat("lat_ts")
+		t = support.DDToR(t)
+		P.K0 = math.Cos(t)
+		if P.K0 < 0. {
+			return merror.New(merror.LatTSLargerThan90)
+		}
+	}
+
+	if PE.Es != 0.0 {
+		op.isSphere = false
+		t = math.Sin(t)
+		P.K0 /= math.Sqrt(1. - PE.Es*t*t)
+		op.apa = support.Authset(PE.Es)
+		op.qp = support.Qsfn(1., PE.E, PE.OneEs)
+	} else {
+		op.isSphere = true
+	}
+	return nil
+}
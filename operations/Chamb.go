@@ -0,0 +1,192 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("chamb",
+		"Chamberlin Trimetric",
+		"\n\tMisc Sph, no inv."+
+			"\n\tlat_1= lon_1= lat_2= lon_2= lat_3= lon_3=",
+		NewChamb,
+	)
+}
+
+const chambTol = 1e-9
+
+// distance and azimuth
+type chambVect struct {
+	r, az float64
+}
+
+// control point data
+type chambControl struct {
+	phi, lam       float64
+	cosphi, sinphi float64
+	v              chambVect
+	p              core.CoordXY
+}
+
+// Chamb implements core.IOperation and core.ConvertLPToXY
+type Chamb struct {
+	core.Operation
+	c                   [3]chambControl
+	p                   core.CoordXY
+	beta0, beta1, beta2 float64
+}
+
+// NewChamb returns a new Chamb
+func NewChamb(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Chamb{}
+	op.System = system
+
+	err := op.chambSetup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func chambVectOf(dphi, c1, s1, c2, s2, dlam float64) chambVect {
+	var v chambVect
+
+	cdl := math.Cos(dlam)
+	if math.Abs(dphi) > 1. || math.Abs(dlam) > 1. {
+		v.r = support.Aacos(s1*s2 + c1*c2*cdl)
+	} else {
+		// more accurate for smaller distances
+		dp := math.Sin(.5 * dphi)
+		dl := math.Sin(.5 * dlam)
+		v.r = 2. * support.Aasin(math.Sqrt(dp*dp+c1*c2*dl*dl))
+	}
+	if math.Abs(v.r) > chambTol {
+		v.az = math.Atan2(c2*math.Sin(dlam), c1*s2-s1*c2*cdl)
+	} else {
+		v.r = 0.
+		v.az = 0.
+	}
+	return v
+}
+
+// law of cosines
+func chambLc(b, c, a float64) float64 {
+	return support.Aacos(.5 * (b*b + c*c - a*a) / (b * c))
+}
+
+// Forward goes forewards
+func (op *Chamb) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+
+	sinphi := math.Sin(lp.Phi)
+	cosphi := math.Cos(lp.Phi)
+
+	// dist/azimiths from control
+	var v [3]chambVect
+	for i := 0; i < 3; i++ {
+		v[i] = chambVectOf(lp.Phi-Q.c[i].phi, Q.c[i].cosphi, Q.c[i].sinphi,
+			cosphi, sinphi, lp.Lam-Q.c[i].lam)
+		if v[i].r == 0.0 {
+			// current point at control point
+			xy := Q.c[i].p
+			return &xy, nil
+		}
+		v[i].az = support.Adjlon(v[i].az - Q.c[i].v.az)
+	}
+
+	// point mean of intercepts
+	xy := Q.p
+	for i := 0; i < 3; i++ {
+		j := i + 1
+		if i == 2 {
+			j = 0
+		}
+		a := chambLc(Q.c[i].v.r, v[i].r, v[j].r)
+		if v[i].az < 0. {
+			a = -a
+		}
+		switch i {
+		case 0: // coord comp unique to each arc
+			xy.X += v[i].r * math.Cos(a)
+			xy.Y -= v[i].r * math.Sin(a)
+		case 1:
+			a = Q.beta1 - a
+			xy.X -= v[i].r * math.Cos(a)
+			xy.Y -= v[i].r * math.Sin(a)
+		default:
+			a = Q.beta2 - a
+			xy.X += v[i].r * math.Cos(a)
+			xy.Y += v[i].r * math.Sin(a)
+		}
+	}
+
+	// mean of arc intercepts
+	xy.X /= 3.
+	xy.Y /= 3.
+
+	return &xy, nil
+}
+
+// Inverse is not allowed
+func (*Chamb) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
+
+func (op *Chamb) chambSetup(sys *core.System) error {
+	Q := op
+	P := op.System
+	ps := sys.ProjString
+
+	// get control point locations
+	for i := 0; i < 3; i++ {
+		phi, _ := ps.GetAsFloat(fmt.Sprintf("lat_%d", i+1))
+		lam, _ := ps.GetAsFloat(fmt.Sprintf("lon_%d", i+1))
+		Q.c[i].phi = support.DDToR(phi)
+		Q.c[i].lam = support.Adjlon(support.DDToR(lam) - P.Lam0)
+		Q.c[i].cosphi = math.Cos(Q.c[i].phi)
+		Q.c[i].sinphi = math.Sin(Q.c[i].phi)
+	}
+
+	// inter ctl pt. distances and azimuths
+	for i := 0; i < 3; i++ {
+		j := i + 1
+		if i == 2 {
+			j = 0
+		}
+		Q.c[i].v = chambVectOf(Q.c[j].phi-Q.c[i].phi, Q.c[i].cosphi, Q.c[i].sinphi,
+			Q.c[j].cosphi, Q.c[j].sinphi, Q.c[j].lam-Q.c[i].lam)
+		if Q.c[i].v.r == 0.0 {
+			return merror.New(merror.ControlPointNoDist)
+		}
+		// co-linearity problem ignored for now
+	}
+
+	Q.beta0 = chambLc(Q.c[0].v.r, Q.c[2].v.r, Q.c[1].v.r)
+	Q.beta1 = chambLc(Q.c[0].v.r, Q.c[1].v.r, Q.c[2].v.r)
+	Q.beta2 = math.Pi - Q.beta0
+
+	Q.c[0].p.Y = Q.c[2].v.r * math.Sin(Q.beta0)
+	Q.c[1].p.Y = Q.c[0].p.Y
+	Q.p.Y = 2. * Q.c[0].p.Y
+	Q.c[2].p.Y = 0.
+	Q.c[1].p.X = 0.5 * Q.c[0].v.r
+	Q.c[0].p.X = -Q.c[1].p.X
+	Q.c[2].p.X = Q.c[0].p.X + Q.c[2].v.r*math.Cos(Q.beta0)
+	Q.p.X = Q.c[2].p.X
+
+	P.Ellipsoid.Es = 0.
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("crast",
+		"Craster Parabolic (Putnins P4)",
+		"\n\tPCyl, Sph",
+		NewCrast,
+	)
+}
+
+const crastXM = 0.97720502380583984317
+const crastRXM = 1.02332670794648848847
+const crastYM = 3.06998012383946546542
+const crastRYM = 0.32573500793527994772
+const crastThird = 0.333333333333333333
+
+// Crast implements core.IOperation and core.ConvertLPToXY
+type Crast struct {
+	core.Operation
+}
+
+// NewCrast returns a new Crast
+func NewCrast(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Crast{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Crast) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lp.Phi *= crastThird
+	xy.X = crastXM * lp.Lam * (2.*math.Cos(lp.Phi+lp.Phi) - 1.)
+	xy.Y = crastYM * math.Sin(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Crast) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = 3. * math.Asin(xy.Y*crastRYM)
+	lp.Lam = xy.X * crastRXM / (2.*math.Cos((lp.Phi+lp.Phi)*crastThird) - 1)
+	return lp, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eck1",
+		"Eckert I",
+		"\n\tPCyl, Sph",
+		NewEck1,
+	)
+}
+
+const eck1FC = .92131773192356127802
+const eck1RP = .31830988618379067154
+
+// Eck1 implements core.IOperation and core.ConvertLPToXY
+type Eck1 struct {
+	core.Operation
+}
+
+// NewEck1 returns a new Eck1
+func NewEck1(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck1{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eck1) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = eck1FC * lp.Lam * (1. - eck1RP*math.Abs(lp.Phi))
+	xy.Y = eck1FC * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eck1) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y / eck1FC
+	lp.Lam = xy.X / (eck1FC * (1. - eck1RP*math.Abs(lp.Phi)))
+	return lp, nil
+}
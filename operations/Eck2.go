@@ -0,0 +1,83 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eck2",
+		"Eckert II",
+		"\n\tPCyl, Sph",
+		NewEck2,
+	)
+}
+
+const eck2FXC = 0.46065886596178063902
+const eck2FYC = 1.44720250911653531871
+const eck2C13 = 0.33333333333333333333
+const eck2OneEps = 1.0000001
+
+// Eck2 implements core.IOperation and core.ConvertLPToXY
+type Eck2 struct {
+	core.Operation
+}
+
+// NewEck2 returns a new Eck2
+func NewEck2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck2{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eck2) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.Y = math.Sqrt(4. - 3.*math.Sin(math.Abs(lp.Phi)))
+	xy.X = eck2FXC * lp.Lam * xy.Y
+	xy.Y = eck2FYC * (2. - xy.Y)
+	if lp.Phi < 0. {
+		xy.Y = -xy.Y
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eck2) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = 2. - math.Abs(xy.Y)/eck2FYC
+	lp.Lam = xy.X / (eck2FXC * lp.Phi)
+	lp.Phi = (4. - lp.Phi*lp.Phi) * eck2C13
+	if math.Abs(lp.Phi) >= 1. {
+		if math.Abs(lp.Phi) > eck2OneEps {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+		if lp.Phi < 0. {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+	} else {
+		lp.Phi = math.Asin(lp.Phi)
+	}
+	if xy.Y < 0 {
+		lp.Phi = -lp.Phi
+	}
+	return lp, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eck3",
+		"Eckert III",
+		"\n\tPCyl, Sph",
+		NewEck3,
+	)
+	core.RegisterConvertLPToXY("kav7",
+		"Kavraisky VII",
+		"\n\tPCyl, Sph",
+		NewKav7,
+	)
+	core.RegisterConvertLPToXY("putp1",
+		"Putnins P1",
+		"\n\tPCyl, Sph",
+		NewPutp1,
+	)
+	core.RegisterConvertLPToXY("wag6",
+		"Wagner VI",
+		"\n\tPCyl, Sph",
+		NewWag6,
+	)
+}
+
+// Eck3 implements core.IOperation and core.ConvertLPToXY
+type Eck3 struct {
+	core.Operation
+	Cx float64
+	Cy float64
+	Ca float64
+	Cb float64
+}
+
+// NewEck3 returns a new Eckert III
+func NewEck3(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck3{
+		Cx: 0.42223820031577120149,
+		Cy: 0.84447640063154240298,
+		Ca: 1.0,
+		Cb: 0.4052847345693510857755,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewKav7 returns a new Kavraisky VII
+func NewKav7(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck3{
+		Cx: 0.8660254037844,
+		Cy: 1.,
+		Ca: 0.,
+		Cb: 0.30396355092701331433,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewPutp1 returns a new Putnins P1
+func NewPutp1(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck3{
+		Cx: 1.89490,
+		Cy: 0.94745,
+		Ca: -0.5,
+		Cb: 0.30396355092701331433,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewWag6 returns a new Wagner VI
+func NewWag6(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck3{
+		Cx: 0.94745,
+		Cy: 0.94745,
+		Ca: 0.,
+		Cb: 0.30396355092701331433,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eck3) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.Y = op.Cy * lp.Phi
+	xy.X = op.Cx * lp.Lam * (op.Ca + support.Asqrt(1.-op.Cb*lp.Phi*lp.Phi))
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eck3) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y / op.Cy
+	denominator := op.Cx * (op.Ca + support.Asqrt(1.-op.Cb*lp.Phi*lp.Phi))
+	if denominator == 0.0 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	lp.Lam = xy.X / denominator
+	return lp, nil
+}
+
+func (op *Eck3) setup() {
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+}
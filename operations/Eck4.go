@@ -0,0 +1,88 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eck4",
+		"Eckert IV",
+		"\n\tPCyl, Sph",
+		NewEck4,
+	)
+}
+
+const eck4Cx = .42223820031577120149
+const eck4Cy = 1.32650042817700232218
+const eck4RCy = .75386330736002178205
+const eck4Cp = 3.57079632679489661922
+const eck4RCp = .28004957675577868795
+const eck4Eps = 1e-7
+const eck4NIter = 6
+
+// Eck4 implements core.IOperation and core.ConvertLPToXY
+type Eck4 struct {
+	core.Operation
+}
+
+// NewEck4 returns a new Eck4
+func NewEck4(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck4{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eck4) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	p := eck4Cp * math.Sin(lp.Phi)
+	V := lp.Phi * lp.Phi
+	lp.Phi *= 0.895168 + V*(0.0218849+V*0.00826809)
+	i := eck4NIter
+	for ; i > 0; i-- {
+		s, c := math.Sincos(lp.Phi)
+		V = (lp.Phi + s*(c+2.) - p) / (1. + c*(c+2.) - s*s)
+		lp.Phi -= V
+		if math.Abs(V) < eck4Eps {
+			break
+		}
+	}
+	if i == 0 {
+		xy.X = eck4Cx * lp.Lam
+		if lp.Phi < 0. {
+			xy.Y = -eck4Cy
+		} else {
+			xy.Y = eck4Cy
+		}
+	} else {
+		xy.X = eck4Cx * lp.Lam * (1. + math.Cos(lp.Phi))
+		xy.Y = eck4Cy * math.Sin(lp.Phi)
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eck4) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = support.Aasin(xy.Y * eck4RCy)
+	c := math.Cos(lp.Phi)
+	lp.Lam = xy.X / (eck4Cx * (1. + c))
+	lp.Phi = support.Aasin((lp.Phi + math.Sin(lp.Phi)*(c+2.)) * eck4RCp)
+	return lp, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eck5",
+		"Eckert V",
+		"\n\tPCyl, Sph",
+		NewEck5,
+	)
+}
+
+const eck5XF = 0.44101277172455148219
+const eck5RXF = 2.26750802723822639137
+const eck5YF = 0.88202554344910296438
+const eck5RYF = 1.13375401361911319568
+
+// Eck5 implements core.IOperation and core.ConvertLPToXY
+type Eck5 struct {
+	core.Operation
+}
+
+// NewEck5 returns a new Eck5
+func NewEck5(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eck5{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eck5) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = eck5XF * (1. + math.Cos(lp.Phi)) * lp.Lam
+	xy.Y = eck5YF * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eck5) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = eck5RYF * xy.Y
+	lp.Lam = eck5RXF * xy.X / (1. + math.Cos(lp.Phi))
+	return lp, nil
+}
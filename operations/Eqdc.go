@@ -0,0 +1,148 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eqdc",
+		"Equidistant Conic",
+		"\n\tConic, Sph&Ell\n\tlat_1= lat_2=",
+		NewEqdc,
+	)
+}
+
+// Eqdc implements core.IOperation and core.ConvertLPToXY
+type Eqdc struct {
+	core.Operation
+	phi1   float64
+	phi2   float64
+	n      float64
+	rho0   float64
+	c      float64
+	en     []float64
+	ellips bool
+}
+
+// NewEqdc returns a new Eqdc
+func NewEqdc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eqdc{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eqdc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+
+	var rho float64
+	if Q.ellips {
+		rho = Q.c - support.Mlfn(lp.Phi, math.Sin(lp.Phi), math.Cos(lp.Phi), Q.en)
+	} else {
+		rho = Q.c - lp.Phi
+	}
+	lp.Lam *= Q.n
+	xy.X = rho * math.Sin(lp.Lam)
+	xy.Y = Q.rho0 - rho*math.Cos(lp.Lam)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eqdc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+	PE := op.System.Ellipsoid
+	var err error
+
+	xy.Y = Q.rho0 - xy.Y
+	rho := math.Hypot(xy.X, xy.Y)
+	if rho != 0.0 {
+		if Q.n < 0. {
+			rho = -rho
+			xy.X = -xy.X
+			xy.Y = -xy.Y
+		}
+		lp.Phi = Q.c - rho
+		if Q.ellips {
+			lp.Phi, err = support.InvMlfn(lp.Phi, PE.Es, Q.en)
+			if err != nil {
+				return nil, err
+			}
+		}
+		lp.Lam = math.Atan2(xy.X, xy.Y) / Q.n
+	} else {
+		lp.Lam = 0.
+		if Q.n > 0. {
+			lp.Phi = support.PiOverTwo
+		} else {
+			lp.Phi = -support.PiOverTwo
+		}
+	}
+	return lp, nil
+}
+
+func (op *Eqdc) setup(sys *core.System) error {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	lat1, _ := ps.GetAsFloat("lat_1")
+	lat2, _ := ps.GetAsFloat("lat_2")
+	Q.phi1 = support.DDToR(lat1)
+	Q.phi2 = support.DDToR(lat2)
+
+	if math.Abs(Q.phi1+Q.phi2) < eps10 {
+		return merror.New(merror.ConicLatEqual)
+	}
+	Q.en = support.Enfn(PE.Es)
+
+	sinphi := math.Sin(Q.phi1)
+	Q.n = sinphi
+	cosphi := math.Cos(Q.phi1)
+	secant := math.Abs(Q.phi1-Q.phi2) >= eps10
+	Q.ellips = PE.Es > 0.
+	if Q.ellips {
+		m1 := support.Msfn(sinphi, cosphi, PE.Es)
+		ml1 := support.Mlfn(Q.phi1, sinphi, cosphi, Q.en)
+		if secant { // secant cone
+			sinphi = math.Sin(Q.phi2)
+			cosphi = math.Cos(Q.phi2)
+			Q.n = (m1 - support.Msfn(sinphi, cosphi, PE.Es)) /
+				(support.Mlfn(Q.phi2, sinphi, cosphi, Q.en) - ml1)
+		}
+		if Q.n == 0. {
+			return merror.New(merror.NOutOfRange)
+		}
+		Q.c = ml1 + m1/Q.n
+		Q.rho0 = Q.c - support.Mlfn(P.Phi0, math.Sin(P.Phi0), math.Cos(P.Phi0), Q.en)
+	} else {
+		if secant {
+			Q.n = (cosphi - math.Cos(Q.phi2)) / (Q.phi2 - Q.phi1)
+		}
+		if Q.n == 0. {
+			return merror.New(merror.NOutOfRange)
+		}
+		Q.c = Q.phi1 + math.Cos(Q.phi1)/Q.n
+		Q.rho0 = Q.c - P.Phi0
+	}
+
+	return nil
+}
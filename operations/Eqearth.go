@@ -0,0 +1,154 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("eqearth",
+		"Equal Earth",
+		"\n\tPCyl, Sph&Ell",
+		NewEqearth,
+	)
+}
+
+const (
+	eqearthA1 = 1.340264
+	eqearthA2 = -0.081106
+	eqearthA3 = 0.000893
+	eqearthA4 = 0.003796
+	eqearthM  = 0.86602540378443864676 // sqrt(3)/2
+
+	eqearthMaxY    = 1.3173627591574 // 90° latitude on a sphere with radius 1
+	eqearthEps     = 1e-11
+	eqearthMaxIter = 12
+)
+
+// Eqearth implements core.IOperation and core.ConvertLPToXY
+type Eqearth struct {
+	core.Operation
+	qp   float64
+	rqda float64
+	apa  []float64
+}
+
+// NewEqearth returns a new Eqearth
+func NewEqearth(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Eqearth{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+
+	op.rqda = 1.0
+
+	// ellipsoidal case
+	if PE.Es != 0.0 {
+		op.apa = support.Authset(PE.Es)
+		op.qp = support.Qsfn(1.0, PE.E, PE.OneEs)
+		op.rqda = math.Sqrt(0.5 * op.qp)
+	}
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Eqearth) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	// spheroidal case, using sine latitude
+	sbeta := math.Sin(lp.Phi)
+
+	// in the ellipsoidal case, we convert sbeta to sine of authalic latitude
+	if PE.Es != 0.0 {
+		sbeta = support.Qsfn(sbeta, PE.E, 1.0-PE.Es) / Q.qp
+
+		// rounding error
+		if math.Abs(sbeta) > 1 {
+			if sbeta > 0 {
+				sbeta = 1
+			} else {
+				sbeta = -1
+			}
+		}
+	}
+
+	psi := math.Asin(eqearthM * sbeta)
+	psi2 := psi * psi
+	psi6 := psi2 * psi2 * psi2
+
+	xy := &core.CoordXY{}
+	xy.X = lp.Lam * math.Cos(psi) /
+		(eqearthM * (eqearthA1 + 3*eqearthA2*psi2 + psi6*(7*eqearthA3+9*eqearthA4*psi2)))
+	xy.Y = psi * (eqearthA1 + eqearthA2*psi2 + psi6*(eqearthA3+eqearthA4*psi2))
+
+	// adjusting x and y for authalic radius
+	xy.X *= Q.rqda
+	xy.Y *= Q.rqda
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Eqearth) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	// adjusting x and y for authalic radius
+	x := xy.X / Q.rqda
+	y := xy.Y / Q.rqda
+
+	// make sure y is inside valid range
+	if y > eqearthMaxY {
+		y = eqearthMaxY
+	} else if y < -eqearthMaxY {
+		y = -eqearthMaxY
+	}
+
+	// Newton-Raphson
+	yc := y
+	converged := false
+	for i := eqearthMaxIter; i > 0; i-- {
+		y2 := yc * yc
+		y6 := y2 * y2 * y2
+		f := yc*(eqearthA1+eqearthA2*y2+y6*(eqearthA3+eqearthA4*y2)) - y
+		fder := eqearthA1 + 3*eqearthA2*y2 + y6*(7*eqearthA3+9*eqearthA4*y2)
+		tol := f / fder
+		yc -= tol
+		if math.Abs(tol) < eqearthEps {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	y2 := yc * yc
+	y6 := y2 * y2 * y2
+
+	lp := &core.CoordLP{}
+	lp.Lam = eqearthM * x *
+		(eqearthA1 + 3*eqearthA2*y2 + y6*(7*eqearthA3+9*eqearthA4*y2)) / math.Cos(yc)
+
+	// for the spheroidal case, this is the latitude
+	lp.Phi = math.Asin(math.Sin(yc) / eqearthM)
+
+	// ellipsoidal case, converting the authalic latitude
+	if PE.Es != 0.0 {
+		lp.Phi = support.Authlat(lp.Phi, Q.apa)
+	}
+
+	return lp, nil
+}
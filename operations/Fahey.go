@@ -0,0 +1,66 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("fahey",
+		"Fahey",
+		"\n\tPcyl, Sph",
+		NewFahey,
+	)
+}
+
+const faheyTol = 1e-6
+
+// Fahey implements core.IOperation and core.ConvertLPToXY
+type Fahey struct {
+	core.Operation
+}
+
+// NewFahey returns a new Fahey
+func NewFahey(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Fahey{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Fahey) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	t := math.Tan(0.5 * lp.Phi)
+	xy.Y = 1.819152 * t
+	xy.X = 0.819152 * lp.Lam * support.Asqrt(1-t*t)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Fahey) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	y := xy.Y / 1.819152
+	lp.Phi = 2. * math.Atan(y)
+	y = 1. - y*y
+	if math.Abs(y) < faheyTol {
+		lp.Lam = 0.
+	} else {
+		lp.Lam = xy.X / (0.819152 * math.Sqrt(y))
+	}
+	return lp, nil
+}
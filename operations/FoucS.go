@@ -0,0 +1,92 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("fouc_s",
+		"Foucaut Sinusoidal",
+		"\n\tPCyl, Sph",
+		NewFoucS,
+	)
+}
+
+const foucSMaxIter = 10
+const foucSLoopTol = 1e-7
+
+// FoucS implements core.IOperation and core.ConvertLPToXY
+type FoucS struct {
+	core.Operation
+	n  float64
+	n1 float64
+}
+
+// NewFoucS returns a new FoucS
+func NewFoucS(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &FoucS{}
+	op.System = system
+
+	n, _ := op.System.ProjString.GetAsFloat("n")
+	if n < 0. || n > 1. {
+		return nil, merror.New(merror.NOutOfRange)
+	}
+	op.n = n
+	op.n1 = 1. - n
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *FoucS) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	t := math.Cos(lp.Phi)
+	xy.X = lp.Lam * t / (op.n + op.n1*t)
+	xy.Y = op.n*lp.Phi + op.n1*math.Sin(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *FoucS) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	if op.n != 0.0 {
+		lp.Phi = xy.Y
+		i := foucSMaxIter
+		for ; i > 0; i-- {
+			V := (op.n*lp.Phi + op.n1*math.Sin(lp.Phi) - xy.Y) /
+				(op.n + op.n1*math.Cos(lp.Phi))
+			lp.Phi -= V
+			if math.Abs(V) < foucSLoopTol {
+				break
+			}
+		}
+		if i == 0 {
+			if xy.Y < 0. {
+				lp.Phi = -support.PiOverTwo
+			} else {
+				lp.Phi = support.PiOverTwo
+			}
+		}
+	} else {
+		lp.Phi = support.Aasin(xy.Y)
+	}
+	V := math.Cos(lp.Phi)
+	lp.Lam = xy.X * (op.n + op.n1*V) / V
+	return lp, nil
+}
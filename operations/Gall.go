@@ -0,0 +1,61 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("gall",
+		"Gall (Gall Stereographic)",
+		"\n\tCyl, Sph",
+		NewGall,
+	)
+}
+
+const gallYF = 1.70710678118654752440
+const gallXF = 0.70710678118654752440
+const gallRYF = 0.58578643762690495119
+const gallRXF = 1.41421356237309504880
+
+// Gall implements core.IOperation and core.ConvertLPToXY
+type Gall struct {
+	core.Operation
+}
+
+// NewGall returns a new Gall
+func NewGall(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Gall{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Gall) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = gallXF * lp.Lam
+	xy.Y = gallYF * math.Tan(.5*lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Gall) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Lam = gallRXF * xy.X
+	lp.Phi = 2. * math.Atan(xy.Y*gallRYF)
+	return lp, nil
+}
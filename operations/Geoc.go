@@ -0,0 +1,55 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("geoc",
+		"Geocentric Latitude",
+		"\n\t",
+		NewGeoc,
+	)
+}
+
+// Geoc implements core.IOperation and core.ConvertLPToXY
+//
+// The forward direction converts geographic latitudes to geocentric
+// latitudes; longitudes are passed through.
+type Geoc struct {
+	core.Operation
+}
+
+// NewGeoc returns a new Geoc
+func NewGeoc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Geoc{}
+	op.System = system
+
+	P := op.System
+	P.IsLatLong = true
+	P.Left = core.IOUnitsAngular
+	P.Right = core.IOUnitsAngular
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Geoc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	lp = core.GeocentricLatitude(op.System, core.DirectionForward, lp)
+	xy := &core.CoordXY{X: lp.Lam, Y: lp.Phi}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Geoc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: xy.X, Phi: xy.Y}
+	lp = core.GeocentricLatitude(op.System, core.DirectionInverse, lp)
+	return lp, nil
+}
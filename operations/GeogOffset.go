@@ -0,0 +1,74 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	// the offsets don't depend on the shape of the earth
+	core.RegisterConvertLPZToLPZ("geogoffset",
+		"Geographic Offset",
+		"\n\t",
+		false,
+		NewGeogOffset,
+	)
+}
+
+// GeogOffset implements core.IOperation and core.ConvertLPZToLPZ
+//
+// It shifts geographic coordinates by a constant offset: dlon and dlat
+// are in arc-seconds, and dh is in meters.
+type GeogOffset struct {
+	core.Operation
+	dlam, dphi, dh float64
+}
+
+// NewGeogOffset returns a new GeogOffset
+func NewGeogOffset(system *core.System, desc *core.OperationDescription) (core.IConvertLPZToLPZ, error) {
+	op := &GeogOffset{}
+	op.System = system
+
+	P := op.System
+	ps := P.ProjString
+
+	dlon, _ := ps.GetAsFloat("dlon")
+	dlat, _ := ps.GetAsFloat("dlat")
+	op.dlam = support.DDToR(dlon / 3600.)
+	op.dphi = support.DDToR(dlat / 3600.)
+	op.dh, _ = ps.GetAsFloat("dh")
+
+	P.Left = core.IOUnitsAngular
+	P.Right = core.IOUnitsAngular
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *GeogOffset) Forward(lpz *core.CoordLPZ) (*core.CoordLPZ, error) {
+	ret := &core.CoordLPZ{}
+
+	ret.Lam = lpz.Lam + op.dlam
+	ret.Phi = lpz.Phi + op.dphi
+	ret.Z = lpz.Z + op.dh
+
+	return ret, nil
+}
+
+// Inverse goes backwards
+func (op *GeogOffset) Inverse(lpz *core.CoordLPZ) (*core.CoordLPZ, error) {
+	ret := &core.CoordLPZ{}
+
+	ret.Lam = lpz.Lam - op.dlam
+	ret.Phi = lpz.Phi - op.dphi
+	ret.Z = lpz.Z - op.dh
+
+	return ret, nil
+}
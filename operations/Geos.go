@@ -0,0 +1,244 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("geos",
+		"Geostationary Satellite View",
+		"\n\tAzi, Sph&Ell\n\th=",
+		NewGeos,
+	)
+}
+
+// Geos implements core.IOperation and core.ConvertLPToXY
+type Geos struct {
+	core.Operation
+	isSphere    bool
+	h           float64
+	radiusP     float64
+	radiusP2    float64
+	radiusPInv2 float64
+	radiusG     float64
+	radiusG1    float64
+	C           float64
+	flipAxis    bool
+}
+
+// NewGeos returns a new Geos
+func NewGeos(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Geos{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Geos) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if op.isSphere {
+		return op.sphericalForward(lp)
+	}
+	return op.ellipsoidalForward(lp)
+}
+
+// Inverse goes backwards
+func (op *Geos) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if op.isSphere {
+		return op.sphericalInverse(xy)
+	}
+	return op.ellipsoidalInverse(xy)
+}
+
+//---------------------------------------------------------------------
+
+func (op *Geos) sphericalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Spheroidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+
+	/* Calculation of the three components of the vector from satellite to
+	 ** position on earth surface (lon,lat).*/
+	tmp := math.Cos(lp.Phi)
+	Vx := math.Cos(lp.Lam) * tmp
+	Vy := math.Sin(lp.Lam) * tmp
+	Vz := math.Sin(lp.Phi)
+
+	/* Check visibility*/
+	if ((Q.radiusG-Vx)*Vx - Vy*Vy - Vz*Vz) < 0. {
+		return nil, merror.New(merror.PointNotVisible)
+	}
+
+	/* Calculation based on view angles from satellite.*/
+	tmp = Q.radiusG - Vx
+	if Q.flipAxis {
+		xy.X = Q.radiusG1 * math.Atan(Vy/math.Hypot(Vz, tmp))
+		xy.Y = Q.radiusG1 * math.Atan(Vz/tmp)
+	} else {
+		xy.X = Q.radiusG1 * math.Atan(Vy/tmp)
+		xy.Y = Q.radiusG1 * math.Atan(Vz/math.Hypot(Vy, tmp))
+	}
+	return xy, nil
+}
+
+func (op *Geos) ellipsoidalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+
+	/* Calculation of geocentric latitude. */
+	lp.Phi = math.Atan(Q.radiusP2 * math.Tan(lp.Phi))
+
+	/* Calculation of the three components of the vector from satellite to
+	 ** position on earth surface (lon,lat).*/
+	r := Q.radiusP / math.Hypot(Q.radiusP*math.Cos(lp.Phi), math.Sin(lp.Phi))
+	Vx := r * math.Cos(lp.Lam) * math.Cos(lp.Phi)
+	Vy := r * math.Sin(lp.Lam) * math.Cos(lp.Phi)
+	Vz := r * math.Sin(lp.Phi)
+
+	/* Check visibility. */
+	if ((Q.radiusG-Vx)*Vx - Vy*Vy - Vz*Vz*Q.radiusPInv2) < 0. {
+		return nil, merror.New(merror.PointNotVisible)
+	}
+
+	/* Calculation based on view angles from satellite. */
+	tmp := Q.radiusG - Vx
+	if Q.flipAxis {
+		xy.X = Q.radiusG1 * math.Atan(Vy/math.Hypot(Vz, tmp))
+		xy.Y = Q.radiusG1 * math.Atan(Vz/tmp)
+	} else {
+		xy.X = Q.radiusG1 * math.Atan(Vy/tmp)
+		xy.Y = Q.radiusG1 * math.Atan(Vz/math.Hypot(Vy, tmp))
+	}
+	return xy, nil
+}
+
+func (op *Geos) sphericalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Spheroidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+
+	var Vy, Vz float64
+
+	/* Setting three components of vector from satellite to position.*/
+	Vx := -1.0
+	if Q.flipAxis {
+		Vz = math.Tan(xy.Y / Q.radiusG1)
+		Vy = math.Tan(xy.X/Q.radiusG1) * math.Sqrt(1.0+Vz*Vz)
+	} else {
+		Vy = math.Tan(xy.X / Q.radiusG1)
+		Vz = math.Tan(xy.Y/Q.radiusG1) * math.Sqrt(1.0+Vy*Vy)
+	}
+
+	/* Calculation of terms in cubic equation and determinant.*/
+	a := Vy*Vy + Vz*Vz + Vx*Vx
+	b := 2 * Q.radiusG * Vx
+	det := (b * b) - 4*a*Q.C
+	if det < 0. {
+		return nil, merror.New(merror.PointNotVisible)
+	}
+
+	/* Calculation of three components of vector from satellite to position.*/
+	k := (-b - math.Sqrt(det)) / (2 * a)
+	Vx = Q.radiusG + k*Vx
+	Vy *= k
+	Vz *= k
+
+	/* Calculation of longitude and latitude.*/
+	lp.Lam = math.Atan2(Vy, Vx)
+	lp.Phi = math.Atan(Vz * math.Cos(lp.Lam) / Vx)
+	return lp, nil
+}
+
+func (op *Geos) ellipsoidalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+
+	var Vy, Vz float64
+
+	/* Setting three components of vector from satellite to position.*/
+	Vx := -1.0
+	if Q.flipAxis {
+		Vz = math.Tan(xy.Y / Q.radiusG1)
+		Vy = math.Tan(xy.X/Q.radiusG1) * math.Hypot(1.0, Vz)
+	} else {
+		Vy = math.Tan(xy.X / Q.radiusG1)
+		Vz = math.Tan(xy.Y/Q.radiusG1) * math.Hypot(1.0, Vy)
+	}
+
+	/* Calculation of terms in cubic equation and determinant.*/
+	a := Vz / Q.radiusP
+	a = Vy*Vy + a*a + Vx*Vx
+	b := 2 * Q.radiusG * Vx
+	det := (b * b) - 4*a*Q.C
+	if det < 0. {
+		return nil, merror.New(merror.PointNotVisible)
+	}
+
+	/* Calculation of three components of vector from satellite to position.*/
+	k := (-b - math.Sqrt(det)) / (2. * a)
+	Vx = Q.radiusG + k*Vx
+	Vy *= k
+	Vz *= k
+
+	/* Calculation of longitude and latitude.*/
+	lp.Lam = math.Atan2(Vy, Vx)
+	lp.Phi = math.Atan(Vz * math.Cos(lp.Lam) / Vx)
+	lp.Phi = math.Atan(Q.radiusPInv2 * math.Tan(lp.Phi))
+	return lp, nil
+}
+
+func (op *Geos) setup(sys *core.System) error {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	Q.h, _ = ps.GetAsFloat("h")
+	if Q.h <= 0. {
+		return merror.New(merror.HLessThanZero)
+	}
+
+	sweepAxis, ok := ps.GetAsString("sweep")
+	if !ok {
+		Q.flipAxis = false
+	} else {
+		if sweepAxis != "x" && sweepAxis != "y" {
+			return merror.New(merror.InvalidSweepAxis)
+		}
+		Q.flipAxis = sweepAxis == "x"
+	}
+
+	Q.radiusG1 = Q.h / PE.A
+	if Q.radiusG1 <= 0 || Q.radiusG1 > 1e10 {
+		return merror.New(merror.HLessThanZero)
+	}
+	Q.radiusG = 1. + Q.radiusG1
+	Q.C = Q.radiusG*Q.radiusG - 1.0
+	if PE.Es != 0.0 {
+		Q.isSphere = false
+		Q.radiusP = math.Sqrt(PE.OneEs)
+		Q.radiusP2 = PE.OneEs
+		Q.radiusPInv2 = PE.ROneEs
+	} else {
+		Q.isSphere = true
+		Q.radiusP = 1.0
+		Q.radiusP2 = 1.0
+		Q.radiusPInv2 = 1.0
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("sinu",
+		"Sinusoidal (Sanson-Flamsteed)",
+		"\n\tPCyl, Sph&Ell",
+		NewSinu,
+	)
+	core.RegisterConvertLPToXY("eck6",
+		"Eckert VI",
+		"\n\tPCyl, Sph",
+		NewEck6,
+	)
+	core.RegisterConvertLPToXY("mbtfps",
+		"McBryde-Thomas Flat-Polar Sinusoidal",
+		"\n\tPCyl, Sph",
+		NewMbtfps,
+	)
+}
+
+const gnSinuMaxIter = 8
+const gnSinuLoopTol = 1e-7
+
+// GnSinu implements core.IOperation and core.ConvertLPToXY
+//
+// This is the General Sinusoidal Series family, which includes
+// the Sinusoidal, Eckert VI and McBryde-Thomas Flat-Polar
+// Sinusoidal projections.
+type GnSinu struct {
+	core.Operation
+	isSphere bool
+
+	// the "opaque" parts
+
+	en []float64
+	m  float64
+	n  float64
+	Cx float64
+	Cy float64
+}
+
+// NewSinu returns a new Sinusoidal
+func NewSinu(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &GnSinu{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+
+	op.en = support.Enfn(PE.Es)
+	if PE.Es != 0.0 {
+		op.isSphere = false
+	} else {
+		op.n = 1.
+		op.m = 0.
+		op.setup()
+	}
+	return op, nil
+}
+
+// NewEck6 returns a new Eckert VI
+func NewEck6(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &GnSinu{
+		m: 1.,
+		n: 2.570796326794896619231321691,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewMbtfps returns a new McBryde-Thomas Flat-Polar Sinusoidal
+func NewMbtfps(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &GnSinu{
+		m: 0.5,
+		n: 1.785398163397448309615660845,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *GnSinu) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if op.isSphere {
+		return op.sphericalForward(lp)
+	}
+	return op.ellipsoidalForward(lp)
+}
+
+// Inverse goes backwards
+func (op *GnSinu) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if op.isSphere {
+		return op.sphericalInverse(xy)
+	}
+	return op.ellipsoidalInverse(xy)
+}
+
+//---------------------------------------------------------------------
+
+func (op *GnSinu) ellipsoidalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	s, c := math.Sincos(lp.Phi)
+	xy.Y = support.Mlfn(lp.Phi, s, c, op.en)
+	xy.X = lp.Lam * c / math.Sqrt(1.-PE.Es*s*s)
+	return xy, nil
+}
+
+func (op *GnSinu) ellipsoidalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	PE := op.System.Ellipsoid
+	var err error
+
+	lp.Phi, err = support.InvMlfn(xy.Y, PE.Es, op.en)
+	if err != nil {
+		return nil, err
+	}
+	s := math.Abs(lp.Phi)
+	if s < support.PiOverTwo {
+		s = math.Sin(lp.Phi)
+		lp.Lam = xy.X * math.Sqrt(1.-PE.Es*s*s) / math.Cos(lp.Phi)
+	} else if (s - eps10) < support.PiOverTwo {
+		lp.Lam = 0.
+	} else {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	return lp, nil
+}
+
+func (op *GnSinu) sphericalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Spheroidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if op.m == 0.0 {
+		if op.n != 1. {
+			lp.Phi = support.Aasin(op.n * math.Sin(lp.Phi))
+		}
+	} else {
+		k := op.n * math.Sin(lp.Phi)
+		i := gnSinuMaxIter
+		for ; i > 0; i-- {
+			V := (op.m*lp.Phi + math.Sin(lp.Phi) - k) / (op.m + math.Cos(lp.Phi))
+			lp.Phi -= V
+			if math.Abs(V) < gnSinuLoopTol {
+				break
+			}
+		}
+		if i == 0 {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+	}
+	xy.X = op.Cx * lp.Lam * (op.m + math.Cos(lp.Phi))
+	xy.Y = op.Cy * lp.Phi
+	return xy, nil
+}
+
+func (op *GnSinu) sphericalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Spheroidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	xy.Y /= op.Cy
+	if op.m != 0.0 {
+		lp.Phi = support.Aasin((op.m*xy.Y + math.Sin(xy.Y)) / op.n)
+	} else if op.n != 1. {
+		lp.Phi = support.Aasin(math.Sin(xy.Y) / op.n)
+	} else {
+		lp.Phi = xy.Y
+	}
+	lp.Lam = xy.X / (op.Cx * (op.m + math.Cos(xy.Y)))
+	return lp, nil
+}
+
+func (op *GnSinu) setup() {
+	op.System.Ellipsoid.Es = 0.
+	op.isSphere = true
+	op.Cy = math.Sqrt((op.m + 1.) / op.n)
+	op.Cx = op.Cy / (op.m + 1.)
+}
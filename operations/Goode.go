@@ -0,0 +1,89 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("goode",
+		"Goode Homolosine",
+		"\n\tPCyl, Sph",
+		NewGoode,
+	)
+}
+
+const goodeYCor = 0.05280
+const goodePhiLim = 0.71093078197902358062
+
+// Goode implements core.IOperation and core.ConvertLPToXY
+//
+// This is the sinusoidal projection between the latitudes of equal
+// scale, and the Mollweide projection poleward of them.
+type Goode struct {
+	core.Operation
+	sinu core.IConvertLPToXY
+	moll core.IConvertLPToXY
+}
+
+// NewGoode returns a new Goode
+func NewGoode(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Goode{}
+	op.System = system
+
+	op.System.Ellipsoid.Es = 0.0
+
+	var err error
+	op.sinu, err = NewSinu(system, desc)
+	if err != nil {
+		return nil, err
+	}
+	op.moll, err = NewMoll(system, desc)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Goode) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if math.Abs(lp.Phi) <= goodePhiLim {
+		return op.sinu.Forward(lp)
+	}
+
+	phi := lp.Phi
+	xy, err := op.moll.Forward(lp)
+	if err != nil {
+		return nil, err
+	}
+	if phi >= 0.0 {
+		xy.Y -= goodeYCor
+	} else {
+		xy.Y += goodeYCor
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Goode) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if math.Abs(xy.Y) <= goodePhiLim {
+		return op.sinu.Inverse(xy)
+	}
+
+	if xy.Y >= 0.0 {
+		xy.Y += goodeYCor
+	} else {
+		xy.Y -= goodeYCor
+	}
+	return op.moll.Inverse(xy)
+}
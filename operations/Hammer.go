@@ -0,0 +1,103 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("hammer",
+		"Hammer & Eckert-Greifendorff",
+		"\n\tMisc Sph,\n\tW= M=",
+		NewHammer,
+	)
+}
+
+// Hammer implements core.IOperation and core.ConvertLPToXY
+type Hammer struct {
+	core.Operation
+	w  float64
+	m  float64
+	rm float64
+}
+
+// NewHammer returns a new Hammer
+func NewHammer(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Hammer{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Hammer) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	cosphi := math.Cos(lp.Phi)
+	lp.Lam *= op.w
+	d := 1. + cosphi*math.Cos(lp.Lam)
+	if d == 0.0 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	d = math.Sqrt(2. / d)
+	xy.X = op.m * d * cosphi * math.Sin(lp.Lam)
+	xy.Y = op.rm * d * math.Sin(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Hammer) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	z := math.Sqrt(1. - 0.25*op.w*op.w*xy.X*xy.X - 0.25*xy.Y*xy.Y)
+	if math.Abs(2.*z*z-1.) < eps10 {
+		return nil, merror.New(merror.LatOrLonExceededLimit)
+	}
+	lp.Lam = support.Aatan2(op.w*xy.X*z, 2.*z*z-1) / op.w
+	lp.Phi = support.Aasin(z * xy.Y)
+	return lp, nil
+}
+
+func (op *Hammer) setup(sys *core.System) error {
+
+	ps := op.System.ProjString
+
+	if ps.ContainsKey("W") {
+		w, _ := ps.GetAsFloat("W")
+		op.w = math.Abs(w)
+		if op.w <= 0. {
+			return merror.New(merror.WOrMZeroOrLess)
+		}
+	} else {
+		op.w = .5
+	}
+	if ps.ContainsKey("M") {
+		m, _ := ps.GetAsFloat("M")
+		op.m = math.Abs(m)
+		if op.m <= 0. {
+			return merror.New(merror.WOrMZeroOrLess)
+		}
+	} else {
+		op.m = 1.
+	}
+
+	op.rm = 1. / op.m
+	op.m /= op.w
+
+	op.System.Ellipsoid.Es = 0.
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("hatano",
+		"Hatano Asymmetrical Equal Area",
+		"\n\tPCyl, Sph",
+		NewHatano,
+	)
+}
+
+const hatanoNIter = 20
+const hatanoEps = 1e-7
+const hatanoOneTol = 1.000001
+const hatanoCN = 2.67595
+const hatanoCS = 2.43763
+const hatanoRCN = 0.37369906014686373063
+const hatanoRCS = 0.41023453108141924738
+const hatanoFYCN = 1.75859
+const hatanoFYCS = 1.93052
+const hatanoRYCN = 0.56863737426006061674
+const hatanoRYCS = 0.51799515156538134803
+const hatanoFXC = 0.85
+const hatanoRXC = 1.17647058823529411764
+
+// Hatano implements core.IOperation and core.ConvertLPToXY
+type Hatano struct {
+	core.Operation
+}
+
+// NewHatano returns a new Hatano
+func NewHatano(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Hatano{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Hatano) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	c := math.Sin(lp.Phi)
+	if lp.Phi < 0. {
+		c *= hatanoCS
+	} else {
+		c *= hatanoCN
+	}
+	for i := hatanoNIter; i > 0; i-- {
+		th1 := (lp.Phi + math.Sin(lp.Phi) - c) / (1. + math.Cos(lp.Phi))
+		lp.Phi -= th1
+		if math.Abs(th1) < hatanoEps {
+			break
+		}
+	}
+	lp.Phi *= .5
+	xy.X = hatanoFXC * lp.Lam * math.Cos(lp.Phi)
+	xy.Y = math.Sin(lp.Phi)
+	if lp.Phi < 0. {
+		xy.Y *= hatanoFYCS
+	} else {
+		xy.Y *= hatanoFYCN
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Hatano) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	th := xy.Y
+	if xy.Y < 0. {
+		th *= hatanoRYCS
+	} else {
+		th *= hatanoRYCN
+	}
+	if math.Abs(th) > 1. {
+		if math.Abs(th) > hatanoOneTol {
+			return nil, merror.New(merror.ToleranceCondition)
+		} else if th > 0. {
+			th = support.PiOverTwo
+		} else {
+			th = -support.PiOverTwo
+		}
+	} else {
+		th = math.Asin(th)
+	}
+
+	lp.Lam = hatanoRXC * xy.X / math.Cos(th)
+	th += th
+	lp.Phi = th + math.Sin(th)
+	if xy.Y < 0. {
+		lp.Phi *= hatanoRCS
+	} else {
+		lp.Phi *= hatanoRCN
+	}
+	if math.Abs(lp.Phi) > 1. {
+		if math.Abs(lp.Phi) > hatanoOneTol {
+			return nil, merror.New(merror.ToleranceCondition)
+		} else if lp.Phi > 0. {
+			lp.Phi = support.PiOverTwo
+		} else {
+			lp.Phi = -support.PiOverTwo
+		}
+	} else {
+		lp.Phi = math.Asin(lp.Phi)
+	}
+	return lp, nil
+}
@@ -0,0 +1,670 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("healpix",
+		"HEALPix",
+		"\n\tSph&Ell\n\trot_xy=",
+		NewHealpix,
+	)
+	core.RegisterConvertLPToXY("rhealpix",
+		"rHEALPix",
+		"\n\tSph&Ell\n\tnorth_square= south_square=",
+		NewRHealpix,
+	)
+}
+
+const healpixEps = 1e-15
+const healpixMaxOrder = 29
+
+type healpixRegion int
+
+const (
+	healpixNorth healpixRegion = iota
+	healpixSouth
+	healpixEquatorial
+)
+
+// capmap describes the polar cap (or the equatorial region) a point is in
+type healpixCapmap struct {
+	cn     int // an integer 0--3 indicating the position of the polar cap
+	x, y   float64
+	region healpixRegion
+}
+
+/*
+	Matrices for counterclockwise rotations of the plane: 0, 90, 180, 270,
+
+-90, -180 and -270 degrees
+*/
+var healpixRot = [7][2][2]float64{
+	{{1, 0}, {0, 1}},
+	{{0, -1}, {1, 0}},
+	{{-1, 0}, {0, -1}},
+	{{0, 1}, {-1, 0}},
+	{{0, 1}, {-1, 0}},
+	{{-1, 0}, {0, -1}},
+	{{0, -1}, {1, 0}},
+}
+
+// Healpix implements core.IOperation and core.ConvertLPToXY
+type Healpix struct {
+	core.Operation
+	isSphere    bool
+	isRHealpix  bool
+	northSquare int
+	southSquare int
+	rotXY       float64
+	qp          float64
+	apa         []float64
+}
+
+// NewHealpix returns a new HEALPix
+func NewHealpix(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Healpix{
+		isRHealpix: false,
+	}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// NewRHealpix returns a new rHEALPix
+func NewRHealpix(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Healpix{
+		isRHealpix: true,
+	}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Healpix) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	phi := lp.Phi
+	if !op.isSphere {
+		phi = op.authLat(phi, false)
+	}
+	xy := healpixSphere(&core.CoordLP{Lam: lp.Lam, Phi: phi})
+
+	if op.isRHealpix {
+		return healpixCombineCaps(xy.X, xy.Y, op.northSquare, op.southSquare, false), nil
+	}
+	return healpixRotate(xy, -op.rotXY), nil
+}
+
+// Inverse goes backwards
+func (op *Healpix) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	xy, err := op.toHealpixPlane(xy)
+	if err != nil {
+		return nil, err
+	}
+
+	lp := healpixSphereInverse(xy)
+	if !op.isSphere {
+		lp.Phi = op.authLat(lp.Phi, true)
+	}
+	return lp, nil
+}
+
+// NestedCell returns the index, in the HEALPix NESTED numbering scheme,
+// of the cell containing the projected point xy at the given order
+// (the grid has 12 * 4^order cells). The point is given in the same
+// units as the output of Forward. The longitudes of the grid are
+// counted from lon_0, so with lon_0=0 the cells are the standard ones.
+func (op *Healpix) NestedCell(xy *core.CoordXY, order int) (int64, error) {
+	ix, iy, face, err := op.cell(xy, order)
+	if err != nil {
+		return 0, err
+	}
+	return healpixXYFToNest(ix, iy, face, order), nil
+}
+
+// RingCell is like NestedCell, but returns the index in the HEALPix
+// RING numbering scheme.
+func (op *Healpix) RingCell(xy *core.CoordXY, order int) (int64, error) {
+	ix, iy, face, err := op.cell(xy, order)
+	if err != nil {
+		return 0, err
+	}
+	return healpixXYFToRing(ix, iy, face, order), nil
+}
+
+//---------------------------------------------------------------------
+
+// toHealpixPlane undoes the rotation (or, for rHEALPix, the
+// rearrangement of the polar caps) of a point in the unit plane
+func (op *Healpix) toHealpixPlane(xy *core.CoordXY) (*core.CoordXY, error) {
+
+	if op.isRHealpix {
+		/* Check whether (x, y) lies in the rHEALPix image. */
+		if !healpixInImage(xy.X, xy.Y, true, op.northSquare, op.southSquare) {
+			return nil, merror.New(merror.InvalidXOrY)
+		}
+		return healpixCombineCaps(xy.X, xy.Y, op.northSquare, op.southSquare, true), nil
+	}
+
+	xy = healpixRotate(xy, op.rotXY)
+
+	/* Check whether (x, y) lies in the HEALPix image */
+	if !healpixInImage(xy.X, xy.Y, false, 0, 0) {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+	return xy, nil
+}
+
+// cell returns the face number and the position within the face of the
+// cell containing the projected point xy
+func (op *Healpix) cell(xy *core.CoordXY, order int) (int64, int64, int, error) {
+	P := op.System
+
+	if order < 0 || order > healpixMaxOrder {
+		return 0, 0, 0, merror.New(merror.InvalidOrder)
+	}
+
+	/* Undo the offsets and scaling applied after Forward */
+	xy = &core.CoordXY{
+		X: (P.ToMeter*xy.X - P.X0) * P.Ellipsoid.Ra,
+		Y: (P.ToMeter*xy.Y - P.Y0) * P.Ellipsoid.Ra,
+	}
+
+	xy, err := op.toHealpixPlane(xy)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	/* The grid is defined on the (authalic) sphere, so there is
+	no need to go back to geodetic latitude here */
+	lp := healpixSphereInverse(xy)
+
+	ix, iy, face := healpixAngToXYF(math.Sin(lp.Phi), lp.Lam, order)
+	return ix, iy, face, nil
+}
+
+// authLat returns the authalic latitude of the geodetic latitude alpha,
+// or the geodetic latitude of the authalic latitude alpha if inverse
+func (op *Healpix) authLat(alpha float64, inverse bool) float64 {
+	PE := op.System.Ellipsoid
+
+	if inverse {
+		/* Geographic latitude from authalic latitude. */
+		return support.Authlat(alpha, op.apa)
+	}
+
+	/* Authalic latitude from geographic latitude. */
+	q := support.Qsfn(math.Sin(alpha), PE.E, 1.0-PE.Es)
+	ratio := q / op.qp
+	if math.Abs(ratio) > 1 {
+		/* Rounding error. */
+		ratio = healpixSign(ratio)
+	}
+	return math.Asin(ratio)
+}
+
+func healpixSign(v float64) float64 {
+	if v > 0 {
+		return 1
+	} else if v < 0 {
+		return -1
+	}
+	return 0
+}
+
+func healpixRotate(p *core.CoordXY, angle float64) *core.CoordXY {
+	s, c := math.Sincos(angle)
+	return &core.CoordXY{
+		X: p.X*c - p.Y*s,
+		Y: p.Y*c + p.X*s,
+	}
+}
+
+// healpixSphere returns the HEALPix projection of the point on the unit sphere
+func healpixSphere(lp *core.CoordLP) *core.CoordXY {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lam := lp.Lam
+	phi := lp.Phi
+	phi0 := math.Asin(2.0 / 3.0)
+
+	/* equatorial region */
+	if math.Abs(phi) <= phi0 {
+		xy.X = lam
+		xy.Y = 3 * support.Pi / 8 * math.Sin(phi)
+	} else {
+		sigma := math.Sqrt(3 * (1 - math.Abs(math.Sin(phi))))
+		cn := math.Floor(2*lam/support.Pi + 2)
+		if cn >= 4 {
+			cn = 3
+		}
+		lamc := -3*support.PiOverFour + support.PiOverTwo*cn
+		xy.X = lamc + (lam-lamc)*sigma
+		xy.Y = healpixSign(phi) * support.PiOverFour * (2 - sigma)
+	}
+	return xy
+}
+
+// healpixSphereInverse is the inverse of healpixSphere
+func healpixSphereInverse(xy *core.CoordXY) *core.CoordLP {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	x := xy.X
+	y := xy.Y
+	y0 := support.PiOverFour
+
+	/* Equatorial region. */
+	if math.Abs(y) <= y0 {
+		lp.Lam = x
+		lp.Phi = math.Asin(8 * y / (3 * support.Pi))
+	} else if math.Abs(y) < support.PiOverTwo {
+		cn := math.Floor(2*x/support.Pi + 2)
+		if cn >= 4 {
+			cn = 3
+		}
+		xc := -3*support.PiOverFour + support.PiOverTwo*cn
+		tau := 2.0 - 4*math.Abs(y)/support.Pi
+		lp.Lam = xc + (x-xc)/tau
+		lp.Phi = healpixSign(y) * math.Asin(1.0-tau*tau/3.0)
+	} else {
+		lp.Lam = -support.Pi
+		lp.Phi = healpixSign(y) * support.PiOverTwo
+	}
+	return lp
+}
+
+// healpixPnpoly returns true if (testx, testy) lies in the polygon vert,
+// using the ray casting algorithm
+func healpixPnpoly(vert [][2]float64, testx, testy float64) bool {
+	nvert := len(vert)
+	counter := 0
+
+	/* Check for boundrary cases */
+	for i := 0; i < nvert; i++ {
+		if testx == vert[i][0] && testy == vert[i][1] {
+			return true
+		}
+	}
+
+	p1 := vert[0]
+	for i := 1; i < nvert; i++ {
+		p2 := vert[i%nvert]
+		if testy > math.Min(p1[1], p2[1]) &&
+			testy <= math.Max(p1[1], p2[1]) &&
+			testx <= math.Max(p1[0], p2[0]) &&
+			p1[1] != p2[1] {
+			xinters := (testy-p1[1])*(p2[0]-p1[0])/(p2[1]-p1[1]) + p1[0]
+			if p1[0] == p2[0] || testx <= xinters {
+				counter++
+			}
+		}
+		p1 = p2
+	}
+	return counter%2 != 0
+}
+
+// healpixInImage returns true if (x, y) lies in the HEALPix image (or
+// the rHEALPix image with the given polar squares)
+func healpixInImage(x, y float64, isRHealpix bool, northSquare, southSquare int) bool {
+	const pi = support.Pi
+	const halfPi = support.PiOverTwo
+	const fortPi = support.PiOverFour
+	const eps = healpixEps
+
+	if !isRHealpix {
+		healpixVertsJit := [][2]float64{
+			{-pi - eps, fortPi},
+			{-3 * fortPi, halfPi + eps},
+			{-halfPi, fortPi + eps},
+			{-fortPi, halfPi + eps},
+			{0.0, fortPi + eps},
+			{fortPi, halfPi + eps},
+			{halfPi, fortPi + eps},
+			{3 * fortPi, halfPi + eps},
+			{pi + eps, fortPi},
+			{pi + eps, -fortPi},
+			{3 * fortPi, -halfPi - eps},
+			{halfPi, -fortPi - eps},
+			{fortPi, -halfPi - eps},
+			{0.0, -fortPi - eps},
+			{-fortPi, -halfPi - eps},
+			{-halfPi, -fortPi - eps},
+			{-3 * fortPi, -halfPi - eps},
+			{-pi - eps, -fortPi},
+		}
+		return healpixPnpoly(healpixVertsJit, x, y)
+	}
+
+	ns := float64(northSquare)
+	ss := float64(southSquare)
+	rhealpixVertsJit := [][2]float64{
+		{-pi - eps, fortPi + eps},
+		{-pi + ns*halfPi - eps, fortPi + eps},
+		{-pi + ns*halfPi - eps, 3*fortPi + eps},
+		{-pi + (ns+1.0)*halfPi + eps, 3*fortPi + eps},
+		{-pi + (ns+1.0)*halfPi + eps, fortPi + eps},
+		{pi + eps, fortPi + eps},
+		{pi + eps, -fortPi - eps},
+		{-pi + (ss+1.0)*halfPi + eps, -fortPi - eps},
+		{-pi + (ss+1.0)*halfPi + eps, -3*fortPi - eps},
+		{-pi + ss*halfPi - eps, -3*fortPi - eps},
+		{-pi + ss*halfPi - eps, -fortPi - eps},
+		{-pi - eps, -fortPi - eps},
+	}
+	return healpixPnpoly(rhealpixVertsJit, x, y)
+}
+
+func healpixRotateIndex(index int) int {
+	switch index {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return 2
+	case 3:
+		return 3
+	case -1:
+		return 4
+	case -2:
+		return 5
+	case -3:
+		return 6
+	}
+	return 0
+}
+
+// healpixGetCap returns the polar cap number, region and tip of the
+// point (x, y). In the forward direction (x, y) is in the HEALPix
+// image; in the inverse it is in the rHEALPix image.
+func healpixGetCap(x, y float64, northSquare, southSquare int, inverse bool) healpixCapmap {
+	const halfPi = support.PiOverTwo
+	const fortPi = support.PiOverFour
+	const eps = healpixEps
+
+	capmap := healpixCapmap{x: x, y: y}
+
+	if !inverse {
+		var c float64
+		if y > fortPi {
+			capmap.region = healpixNorth
+			c = halfPi
+		} else if y < -fortPi {
+			capmap.region = healpixSouth
+			c = -halfPi
+		} else {
+			capmap.region = healpixEquatorial
+			capmap.cn = 0
+			return capmap
+		}
+		/* polar region */
+		if x < -halfPi {
+			capmap.cn = 0
+			capmap.x = (-3 * fortPi)
+			capmap.y = c
+		} else if x >= -halfPi && x < 0 {
+			capmap.cn = 1
+			capmap.x = -fortPi
+			capmap.y = c
+		} else if x >= 0 && x < halfPi {
+			capmap.cn = 2
+			capmap.x = fortPi
+			capmap.y = c
+		} else {
+			capmap.cn = 3
+			capmap.x = 3 * fortPi
+			capmap.y = c
+		}
+		return capmap
+	}
+
+	if y > fortPi {
+		capmap.region = healpixNorth
+		capmap.x = -3*fortPi + float64(northSquare)*halfPi
+		capmap.y = halfPi
+		x = x - float64(northSquare)*halfPi
+	} else if y < -fortPi {
+		capmap.region = healpixSouth
+		capmap.x = -3*fortPi + float64(southSquare)*halfPi
+		capmap.y = -halfPi
+		x = x - float64(southSquare)*halfPi
+	} else {
+		capmap.region = healpixEquatorial
+		capmap.cn = 0
+		return capmap
+	}
+
+	/* Polar Region, find the HEALPix polar cap number that
+	   x, y moves to when rHEALPix polar square is disassembled. */
+	if capmap.region == healpixNorth {
+		if y >= -x-fortPi-eps && y < x+5*fortPi-eps {
+			capmap.cn = (northSquare + 1) % 4
+		} else if y > -x-fortPi+eps && y >= x+5*fortPi-eps {
+			capmap.cn = (northSquare + 2) % 4
+		} else if y <= -x-fortPi+eps && y > x+5*fortPi+eps {
+			capmap.cn = (northSquare + 3) % 4
+		} else {
+			capmap.cn = northSquare
+		}
+	} else {
+		if y <= x+fortPi+eps && y > -x-5*fortPi+eps {
+			capmap.cn = (southSquare + 1) % 4
+		} else if y < x+fortPi-eps && y <= -x-5*fortPi+eps {
+			capmap.cn = (southSquare + 2) % 4
+		} else if y >= x+fortPi-eps && y < -x-5*fortPi-eps {
+			capmap.cn = (southSquare + 3) % 4
+		} else {
+			capmap.cn = southSquare
+		}
+	}
+	return capmap
+}
+
+// healpixCombineCaps rearranges the polar triangles of the HEALPix
+// image into the polar squares of the rHEALPix image, or back again if
+// inverse
+func healpixCombineCaps(x, y float64, northSquare, southSquare int, inverse bool) *core.CoordXY {
+	capmap := healpixGetCap(x, y, northSquare, southSquare, inverse)
+	if capmap.region == healpixEquatorial {
+		return &core.CoordXY{X: capmap.x, Y: capmap.y}
+	}
+
+	var pole, rot, dest int
+	if capmap.region == healpixNorth {
+		pole = northSquare
+	} else {
+		pole = southSquare
+	}
+	if !inverse {
+		/* Rotate (x, y) about its polar cap tip and translate it to
+		   north_square or south_square. */
+		dest = pole
+		if capmap.region == healpixNorth {
+			rot = healpixRotateIndex(capmap.cn - pole)
+		} else {
+			rot = healpixRotateIndex(-1 * (capmap.cn - pole))
+		}
+	} else {
+		/* Unrotate (x, y) and translate it back. */
+		dest = capmap.cn
+		if capmap.region == healpixNorth {
+			rot = healpixRotateIndex(-1 * (capmap.cn - pole))
+		} else {
+			rot = healpixRotateIndex(capmap.cn - pole)
+		}
+	}
+
+	m := healpixRot[rot]
+	vx := x - capmap.x
+	vy := y - capmap.y
+	return &core.CoordXY{
+		X: m[0][0]*vx + m[0][1]*vy + (-3*support.PiOverFour + float64(dest)*support.PiOverTwo),
+		Y: m[1][0]*vx + m[1][1]*vy + capmap.y,
+	}
+}
+
+// healpixAngToXYF returns the position (ix, iy) within the base cell
+// face, at the given order, of the point with z=cos(colatitude) and
+// longitude phi
+func healpixAngToXYF(z, phi float64, order int) (int64, int64, int) {
+	nside := int64(1) << uint(order)
+
+	za := math.Abs(z)
+	tt := math.Mod(phi/support.PiOverTwo, 4.0)
+	if tt < 0 {
+		tt += 4.0
+	}
+
+	var ix, iy int64
+	var face int
+
+	if za <= 2./3. { /* Equatorial region */
+		temp1 := float64(nside) * (0.5 + tt)
+		temp2 := float64(nside) * (z * 0.75)
+		jp := int64(temp1 - temp2) /* index of ascending edge line */
+		jm := int64(temp1 + temp2) /* index of descending edge line */
+		ifp := jp >> uint(order)   /* in {0,4} */
+		ifm := jm >> uint(order)
+		if ifp == ifm {
+			face = int(ifp%4) + 4
+		} else if ifp < ifm {
+			face = int(ifp % 4)
+		} else {
+			face = int(ifm%4) + 8
+		}
+		ix = jm & (nside - 1)
+		iy = nside - (jp & (nside - 1)) - 1
+	} else { /* polar region, za > 2/3 */
+		ntt := int(tt)
+		if ntt >= 4 {
+			ntt = 3
+		}
+		tp := tt - float64(ntt)
+		tmp := float64(nside) * math.Sqrt(3*(1-za))
+
+		jp := int64(tp * tmp)         /* increasing edge line index */
+		jm := int64((1.0 - tp) * tmp) /* decreasing edge line index */
+		if jp > nside-1 {
+			jp = nside - 1
+		}
+		if jm > nside-1 {
+			jm = nside - 1
+		}
+		if z >= 0 {
+			face = ntt
+			ix = nside - jm - 1
+			iy = nside - jp - 1
+		} else {
+			face = ntt + 8
+			ix = jp
+			iy = jm
+		}
+	}
+	return ix, iy, face
+}
+
+// healpixSpreadBits interleaves the bits of v with zeros
+func healpixSpreadBits(v int64) int64 {
+	var r int64
+	for i := uint(0); i < 32; i++ {
+		r |= ((v >> i) & 1) << (2 * i)
+	}
+	return r
+}
+
+func healpixXYFToNest(ix, iy int64, face int, order int) int64 {
+	return (int64(face) << uint(2*order)) + healpixSpreadBits(ix) + (healpixSpreadBits(iy) << 1)
+}
+
+/* ring number and longitude offset of the southernmost corner of each face */
+var healpixJrll = [12]int64{2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4}
+var healpixJpll = [12]int64{1, 3, 5, 7, 0, 2, 4, 6, 1, 3, 5, 7}
+
+func healpixXYFToRing(ix, iy int64, face int, order int) int64 {
+	nside := int64(1) << uint(order)
+	nl4 := 4 * nside
+	ncap := 2 * nside * (nside - 1)
+	npix := 12 * nside * nside
+
+	jr := healpixJrll[face]*nside - ix - iy - 1
+
+	var nr, nBefore, kshift int64
+	if jr < nside {
+		nr = jr
+		nBefore = 2 * nr * (nr - 1)
+		kshift = 0
+	} else if jr > 3*nside {
+		nr = nl4 - jr
+		nBefore = npix - 2*(nr+1)*nr
+		kshift = 0
+	} else {
+		nr = nside
+		nBefore = ncap + (jr-nside)*nl4
+		kshift = (jr - nside) & 1
+	}
+
+	jp := (healpixJpll[face]*nr + ix - iy + 1 + kshift) / 2
+	if jp > nl4 {
+		jp -= nl4
+	} else if jp < 1 {
+		jp += nl4
+	}
+
+	return nBefore + jp - 1
+}
+
+func (op *Healpix) setup(sys *core.System) error {
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	if op.isRHealpix {
+		op.northSquare, _ = ps.GetAsInt("north_square")
+		op.southSquare, _ = ps.GetAsInt("south_square")
+
+		/* Check for valid north_square and south_square inputs. */
+		if op.northSquare < 0 || op.northSquare > 3 {
+			return merror.New(merror.InvalidSquare)
+		}
+		if op.southSquare < 0 || op.southSquare > 3 {
+			return merror.New(merror.InvalidSquare)
+		}
+	} else {
+		angle, _ := ps.GetAsFloat("rot_xy")
+		op.rotXY = support.DDToR(angle)
+	}
+
+	if PE.Es != 0.0 {
+		op.isSphere = false
+		op.apa = support.Authset(PE.Es)           /* For auth_lat(). */
+		op.qp = support.Qsfn(1.0, PE.E, PE.OneEs) /* For auth_lat(). */
+		PE.A = PE.A * math.Sqrt(0.5*op.qp)        /* Set A to authalic radius. */
+		PE.Ra = 1. / PE.A
+	} else {
+		op.isSphere = true
+	}
+
+	return nil
+}
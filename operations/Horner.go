@@ -0,0 +1,203 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertXYZTToXYZT("horner",
+		"Horner polynomial evaluation",
+		"\n\t",
+		NewHorner,
+	)
+}
+
+// Horner implements core.IOperation and core.ConvertXYZTToXYZT
+//
+// It evaluates a pair of bivariate polynomials, or one complex polynomial,
+// in X and Y, relative to an origin, using a double Horner scheme. Z and T
+// are passed through.
+//
+// The real coefficients fwd_u, fwd_v, inv_u and inv_v are given as
+// (deg+1)(deg+2)/2 comma-separated values each: the u lists run over the
+// powers of x (innermost) for each power of y; the v lists run over the
+// powers of y for each power of x. The complex coefficients fwd_c and
+// inv_c are given as 2(deg+1) values: the real (northing) and imaginary
+// (easting) parts of each coefficient, from the constant term upwards.
+type Horner struct {
+	core.Operation
+	order     int
+	limit     float64
+	isComplex bool
+	uneg      bool
+	vneg      bool
+
+	fwdU, fwdV []float64
+	invU, invV []float64
+	fwdC, invC []float64
+
+	fwdOrigin []float64
+	invOrigin []float64
+}
+
+// NewHorner returns a new Horner
+func NewHorner(system *core.System, desc *core.OperationDescription) (core.IConvertXYZTToXYZT, error) {
+	op := &Horner{}
+	op.System = system
+
+	err := op.setup()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Horner) Forward(xyzt *core.CoordXYZT) (*core.CoordXYZT, error) {
+	if op.isComplex {
+		return op.complexHorner(xyzt, op.fwdC, op.fwdOrigin)
+	}
+	return op.realHorner(xyzt, op.fwdU, op.fwdV, op.fwdOrigin)
+}
+
+// Inverse goes backwards
+func (op *Horner) Inverse(xyzt *core.CoordXYZT) (*core.CoordXYZT, error) {
+	if op.isComplex {
+		return op.complexHorner(xyzt, op.invC, op.invOrigin)
+	}
+	return op.realHorner(xyzt, op.invU, op.invV, op.invOrigin)
+}
+
+func (op *Horner) realHorner(xyzt *core.CoordXYZT, cu, cv, origin []float64) (*core.CoordXYZT, error) {
+	e := xyzt.X - origin[0]
+	n := xyzt.Y - origin[1]
+	if math.Abs(n) > op.limit || math.Abs(e) > op.limit {
+		return nil, merror.New(merror.OutsideRange)
+	}
+
+	// double Horner's scheme, working from the highest power down
+	iu := len(cu) - 1
+	iv := len(cv) - 1
+
+	E := cu[iu]
+	N := cv[iv]
+	for r := op.order; r > 0; r-- {
+		iu--
+		iv--
+		u := cv[iv]
+		v := cu[iu]
+		for c := op.order; c >= r; c-- {
+			iu--
+			iv--
+			u = n*u + cv[iv]
+			v = e*v + cu[iu]
+		}
+		N = e*N + u
+		E = n*E + v
+	}
+
+	return &core.CoordXYZT{X: E, Y: N, Z: xyzt.Z, T: xyzt.T}, nil
+}
+
+func (op *Horner) complexHorner(xyzt *core.CoordXYZT, c, origin []float64) (*core.CoordXYZT, error) {
+	e := xyzt.X - origin[0]
+	n := xyzt.Y - origin[1]
+	if op.uneg {
+		e = -e
+	}
+	if op.vneg {
+		n = -n
+	}
+	if math.Abs(n) > op.limit || math.Abs(e) > op.limit {
+		return nil, merror.New(merror.OutsideRange)
+	}
+
+	// Horner's scheme on (N + iE) = sum of c[k] (n + ie)^k
+	i := len(c) - 1
+	E := c[i]
+	N := c[i-1]
+	for i -= 2; i > 0; i -= 2 {
+		w := n*E + e*N + c[i]
+		N = n*N - e*E + c[i-1]
+		E = w
+	}
+
+	return &core.CoordXYZT{X: E, Y: N, Z: xyzt.Z, T: xyzt.T}, nil
+}
+
+func (op *Horner) setup() error {
+	ps := op.System.ProjString
+
+	deg, ok := ps.GetAsInt("deg")
+	if !ok {
+		return merror.New(merror.DegMissing)
+	}
+	if deg < 0 {
+		return merror.New(merror.InvalidArg)
+	}
+	op.order = deg
+
+	op.isComplex = ps.ContainsKey("fwd_c") || ps.ContainsKey("inv_c")
+
+	var err error
+	if op.isComplex {
+		op.uneg = ps.ContainsKey("uneg")
+		op.vneg = ps.ContainsKey("vneg")
+
+		n := 2*deg + 2
+		if op.fwdC, err = hornerCoefs(ps, "fwd_c", n); err != nil {
+			return err
+		}
+		if op.invC, err = hornerCoefs(ps, "inv_c", n); err != nil {
+			return err
+		}
+	} else {
+		n := (deg + 1) * (deg + 2) / 2
+		if op.fwdU, err = hornerCoefs(ps, "fwd_u", n); err != nil {
+			return err
+		}
+		if op.fwdV, err = hornerCoefs(ps, "fwd_v", n); err != nil {
+			return err
+		}
+		if op.invU, err = hornerCoefs(ps, "inv_u", n); err != nil {
+			return err
+		}
+		if op.invV, err = hornerCoefs(ps, "inv_v", n); err != nil {
+			return err
+		}
+	}
+
+	if op.fwdOrigin, err = hornerCoefs(ps, "fwd_origin", 2); err != nil {
+		return err
+	}
+	if op.invOrigin, err = hornerCoefs(ps, "inv_origin", 2); err != nil {
+		return err
+	}
+
+	op.limit = 500000.0
+	if ps.ContainsKey("range") {
+		op.limit, _ = ps.GetAsFloat("range")
+	}
+
+	return nil
+}
+
+// hornerCoefs reads a comma-separated list of exactly n values
+func hornerCoefs(ps *support.ProjString, key string, n int) ([]float64, error) {
+	coefs, ok := ps.GetAsFloats(key)
+	if !ok || len(coefs) != n {
+		return nil, merror.New(merror.MalformedCoefficients, key)
+	}
+	return coefs, nil
+}
@@ -0,0 +1,269 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("igh",
+		"Interrupted Goode Homolosine",
+		"\n\tPCyl, Sph",
+		NewIgh,
+	)
+}
+
+/* 40d 44' 11.8" [degrees] */
+var ighPhiBoundary = support.DDToR(40 + 44/60. + 11.8/3600.)
+
+const ighEpsln = 1.e-10 /* allow a little 'slack' on zone edge positions */
+
+// ighZone is one of the twelve sub-projections of an interrupted
+// Goode Homolosine, each with its own central meridian and offsets
+type ighZone struct {
+	op   core.IConvertLPToXY
+	x0   float64
+	y0   float64
+	lam0 float64
+}
+
+// Igh implements core.IOperation and core.ConvertLPToXY
+//
+// The same type is used for both the land-focused (igh) and the
+// ocean-focused (igh_o) interruptions.
+type Igh struct {
+	core.Operation
+	zones [12]ighZone
+	dy0   float64
+
+	// selects the zone for a point, in degrees
+	zoneOf func(lam, phi float64) int
+	// reports whether the inverse of a point in the zone really lies
+	// on that lobe of the map, and not in an interruption
+	inZone func(z int, lam, phi float64) bool
+}
+
+// NewIgh returns a new Interrupted Goode Homolosine
+func NewIgh(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Igh{
+		zoneOf: ighZoneOf,
+		inZone: ighInZone,
+	}
+	op.System = system
+
+	/*
+	   Zones:
+
+	     -180            -40                       180
+	       +--------------+-------------------------+    Zones 1,2,9,10,11 & 12:
+	       |1             |2                        |      Mollweide projection
+	       |              |                         |
+	       +--------------+-------------------------+    Zones 3,4,5,6,7 & 8:
+	       |3             |4                        |      Sinusoidal projection
+	       |              |                         |
+	     0 +-------+------+-+-----------+-----------+
+	       |5      |6       |7          |8          |
+	       |       |        |           |           |
+	       +-------+--------+-----------+-----------+
+	       |9      |10      |11         |12         |
+	       |       |        |           |           |
+	       +-------+--------+-----------+-----------+
+	     -180    -100      -20         80          180
+	*/
+	err := op.setup(
+		[6]float64{-100, 30, -160, -60, 20, 140}, // zones 3 to 8
+		[6]float64{-100, 30, -160, -60, 20, 140}, // zones 1, 2 and 9 to 12
+		2,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func ighZoneOf(lam, phi float64) int {
+	phiBoundary := support.RToDD(ighPhiBoundary)
+
+	if phi >= phiBoundary { /* 1|2 */
+		if lam <= -40 {
+			return 1
+		}
+		return 2
+	} else if phi >= 0 { /* 3|4 */
+		if lam <= -40 {
+			return 3
+		}
+		return 4
+	} else if phi >= -phiBoundary { /* 5|6|7|8 */
+		if lam <= -100 {
+			return 5
+		} else if lam <= -20 {
+			return 6
+		} else if lam <= 80 {
+			return 7
+		}
+		return 8
+	}
+	/* 9|10|11|12 */
+	if lam <= -100 {
+		return 9
+	} else if lam <= -20 {
+		return 10
+	} else if lam <= 80 {
+		return 11
+	}
+	return 12
+}
+
+func ighInZone(z int, lam, phi float64) bool {
+	between := func(v, lo, hi float64) bool {
+		eps := support.RToDD(ighEpsln)
+		return v >= lo-eps && v <= hi+eps
+	}
+
+	switch z {
+	case 1:
+		return between(lam, -180, -40) ||
+			(between(lam, -40, -10) && between(phi, 60, 90))
+	case 2:
+		return between(lam, -40, 180) ||
+			(between(lam, -180, -160) && between(phi, 50, 90)) ||
+			(between(lam, -50, -40) && between(phi, 60, 90))
+	case 3:
+		return between(lam, -180, -40)
+	case 4:
+		return between(lam, -40, 180)
+	case 5:
+		return between(lam, -180, -100)
+	case 6:
+		return between(lam, -100, -20)
+	case 7:
+		return between(lam, -20, 80)
+	case 8:
+		return between(lam, 80, 180)
+	case 9:
+		return between(lam, -180, -100)
+	case 10:
+		return between(lam, -100, -20)
+	case 11:
+		return between(lam, -20, 80)
+	case 12:
+		return between(lam, 80, 180)
+	}
+	return false
+}
+
+// Forward goes forewards
+func (op *Igh) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	z := op.zoneOf(support.RToDD(lp.Lam), support.RToDD(lp.Phi))
+	zone := &op.zones[z-1]
+
+	xy, err := zone.op.Forward(&core.CoordLP{Lam: lp.Lam - zone.lam0, Phi: lp.Phi})
+	if err != nil {
+		return nil, err
+	}
+	xy.X += zone.x0
+	xy.Y += zone.y0
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Igh) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	y90 := op.dy0 + math.Sqrt(2.0) /* lt=90 corresponds to y=y0+sqrt(2) */
+
+	if xy.Y > y90+ighEpsln || xy.Y < -y90+ighEpsln {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+
+	/* x and y are close enough to lam and phi to pick the zone */
+	z := op.zoneOf(support.RToDD(xy.X), support.RToDD(xy.Y))
+	zone := &op.zones[z-1]
+
+	lp, err := zone.op.Inverse(&core.CoordXY{X: xy.X - zone.x0, Y: xy.Y - zone.y0})
+	if err != nil {
+		return nil, err
+	}
+	lp.Lam += zone.lam0
+
+	/* projectable? */
+	if !op.inZone(z, support.RToDD(lp.Lam), support.RToDD(lp.Phi)) {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+	return lp, nil
+}
+
+// setup creates the zones from the central meridians, in degrees, of
+// the sinusoidal and the Mollweide zones, each listed from north to
+// south and from west to east, and works out the offset between the two
+func (op *Igh) setup(sinuLon0 [6]float64, mollLon0 [6]float64, nNorth int) error {
+	P := op.System
+	P.Ellipsoid.Es = 0.0
+
+	newZone := func(n int, creator core.ConvertLPToXYCreatorFuncType, y0, lon0 float64) error {
+		zop, err := creator(P, nil)
+		if err != nil {
+			return err
+		}
+		op.zones[n-1] = ighZone{
+			op:   zop,
+			x0:   support.DDToR(lon0),
+			y0:   y0,
+			lam0: support.DDToR(lon0),
+		}
+		return nil
+	}
+
+	/* sinusoidal zones, which follow the northern mollweide zones */
+	for i, lon0 := range sinuLon0 {
+		err := newZone(nNorth+i+1, NewSinu, 0, lon0)
+		if err != nil {
+			return err
+		}
+	}
+
+	/* mollweide zones */
+	err := newZone(1, NewMoll, 0, mollLon0[0])
+	if err != nil {
+		return err
+	}
+
+	/* y0 + xy1.y = xy3.y for lt = 40d44'11.8" */
+	lp := core.CoordLP{Lam: 0, Phi: ighPhiBoundary}
+	xy1, err := op.zones[0].op.Forward(&lp)
+	if err != nil {
+		return err
+	}
+	lp = core.CoordLP{Lam: 0, Phi: ighPhiBoundary}
+	xy3, err := op.zones[nNorth].op.Forward(&lp)
+	if err != nil {
+		return err
+	}
+	op.dy0 = xy3.Y - xy1.Y
+	op.zones[0].y0 = op.dy0
+
+	/* mollweide zones (cont'd) */
+	for i := 1; i < 6; i++ {
+		if i < nNorth {
+			err = newZone(i+1, NewMoll, op.dy0, mollLon0[i])
+		} else {
+			err = newZone(i+7, NewMoll, -op.dy0, mollLon0[i])
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("igh_o",
+		"Interrupted Goode Homolosine Oceanic View",
+		"\n\tPCyl, Sph",
+		NewIghO,
+	)
+}
+
+// NewIghO returns a new Interrupted Goode Homolosine, with the
+// interruptions placed to emphasize the oceans
+func NewIghO(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Igh{
+		zoneOf: ighOZoneOf,
+		inZone: ighOInZone,
+	}
+	op.System = system
+
+	/*
+	   Zones:
+
+	     -180           -90               60                                180
+	       +--------------+----------------+----------------------------------+
+	       |       1      |        2       |                3                 |
+	       +--------------+----------------+----------------------------------+
+	       |       4      |        5       |                6                 |
+	     0 +-------------+------------------+---------------------------------+
+	       |      7      |         8         |               9                |
+	       +-------------+-------------------+--------------------------------+
+	       |     10      |         11        |              12                |
+	       +-------------+-------------------+--------------------------------+
+	     -180           -60                 90                               180
+
+	   Zones 1, 2, 3, 10, 11 & 12 use the Mollweide projection,
+	   zones 4 to 9 the sinusoidal projection.
+	*/
+	err := op.setup(
+		[6]float64{-140, -10, 130, -110, 20, 150}, // zones 4 to 9
+		[6]float64{-140, -10, 130, -110, 20, 150}, // zones 1 to 3 and 10 to 12
+		3,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func ighOZoneOf(lam, phi float64) int {
+	phiBoundary := support.RToDD(ighPhiBoundary)
+
+	if phi >= phiBoundary {
+		if lam <= -90 {
+			return 1
+		} else if lam >= 60 {
+			return 3
+		}
+		return 2
+	} else if phi >= 0 {
+		if lam <= -90 {
+			return 4
+		} else if lam >= 60 {
+			return 6
+		}
+		return 5
+	} else if phi >= -phiBoundary {
+		if lam <= -60 {
+			return 7
+		} else if lam >= 90 {
+			return 9
+		}
+		return 8
+	}
+	if lam <= -60 {
+		return 10
+	} else if lam >= 90 {
+		return 12
+	}
+	return 11
+}
+
+func ighOInZone(z int, lam, phi float64) bool {
+	between := func(v, lo, hi float64) bool {
+		eps := support.RToDD(ighEpsln)
+		return v >= lo-eps && v <= hi+eps
+	}
+
+	switch z {
+	/* projectable ranges, with extension lobes in zones 1, 3 and 11 */
+	case 1:
+		return between(lam, -180, -90) ||
+			(between(lam, 160, 180) && between(phi, 50, 90))
+	case 2:
+		return between(lam, -90, 60)
+	case 3:
+		return between(lam, 60, 180) ||
+			(between(lam, -180, -160) && between(phi, 50, 90))
+	case 4:
+		return between(lam, -180, -90)
+	case 5:
+		return between(lam, -90, 60)
+	case 6:
+		return between(lam, 60, 180)
+	case 7:
+		return between(lam, -180, -60)
+	case 8:
+		return between(lam, -60, 90)
+	case 9:
+		return between(lam, 90, 180)
+	case 10:
+		return between(lam, -180, -60)
+	case 11:
+		return between(lam, -60, 90) ||
+			(between(lam, 90, 100) && between(phi, -90, -55))
+	case 12:
+		return between(lam, 90, 180)
+	}
+	return false
+}
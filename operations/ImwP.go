@@ -0,0 +1,265 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("imw_p",
+		"International Map of the World Polyconic",
+		"\n\tMod. Polyconic, Ell\n\tlat_1= and lat_2= [lon_1=]",
+		NewImwP,
+	)
+}
+
+const imwPTol = 1e-10
+const imwPMaxIter = 1000
+
+type imwPMode int
+
+const (
+	imwPNoneIsZero imwPMode = 0
+	imwPPhi1IsZero imwPMode = 1
+	imwPPhi2IsZero imwPMode = -1
+)
+
+// ImwP implements core.IOperation and core.ConvertLPToXY
+type ImwP struct {
+	core.Operation
+	p     float64
+	pp    float64
+	q     float64
+	qp    float64
+	r1    float64
+	r2    float64
+	sphi1 float64
+	sphi2 float64
+	c2    float64
+	phi1  float64
+	phi2  float64
+	lam1  float64
+	en    []float64
+	mode  imwPMode
+}
+
+// NewImwP returns a new ImwP
+func NewImwP(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ImwP{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *ImwP) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy, _, err := op.locFor(lp)
+	if err != nil {
+		return nil, err
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *ImwP) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+
+	lp.Phi = Q.phi2
+	lp.Lam = xy.X / math.Cos(lp.Phi)
+	i := 0
+	for {
+		t, yc, err := Q.locFor(&core.CoordLP{Lam: lp.Lam, Phi: lp.Phi})
+		if err != nil {
+			return nil, err
+		}
+		denom := t.Y - yc
+		if denom != 0.0 || math.Abs(t.Y-xy.Y) > imwPTol {
+			if denom == 0.0 {
+				return nil, merror.New(merror.ToleranceCondition)
+			}
+			lp.Phi = ((lp.Phi - Q.phi1) * (xy.Y - yc) / denom) + Q.phi1
+		}
+		// on the central meridian t.X is zero, and so is the longitude
+		if t.X != 0.0 && math.Abs(t.X-xy.X) > imwPTol {
+			lp.Lam = lp.Lam * xy.X / t.X
+		}
+		i++
+		if !(i < imwPMaxIter && (math.Abs(t.X-xy.X) > imwPTol || math.Abs(t.Y-xy.Y) > imwPTol)) {
+			break
+		}
+	}
+	if i == imwPMaxIter {
+		return nil, merror.New(merror.NonConvergent)
+	}
+	// points off the sheet can converge to something which isn't a
+	// point on the earth
+	if math.Abs(lp.Phi) > support.PiOverTwo || math.Abs(lp.Lam) > support.Pi {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	return lp, nil
+}
+
+// locFor returns the projected point and the y value of the
+// intersection with the lower standard parallel; points too far from
+// the sheet have no intersection, and give an error
+func (op *ImwP) locFor(lp *core.CoordLP) (*core.CoordXY, float64, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+	PE := op.System.Ellipsoid
+	yc := 0.0
+
+	if lp.Phi == 0.0 {
+		xy.X = lp.Lam
+		xy.Y = 0.
+		return xy, yc, nil
+	}
+
+	var xb, yb, xc float64
+	sp := math.Sin(lp.Phi)
+	m := support.Mlfn(lp.Phi, sp, math.Cos(lp.Phi), Q.en)
+	xa := Q.pp + Q.qp*m
+	ya := Q.p + Q.q*m
+	R := 1. / (math.Tan(lp.Phi) * math.Sqrt(1.-PE.Es*sp*sp))
+	r := R*R - xa*xa
+	if r < 0.0 {
+		return nil, yc, merror.New(merror.ToleranceCondition)
+	}
+	C := math.Sqrt(r)
+	if lp.Phi < 0. {
+		C = -C
+	}
+	C += ya - R
+	if Q.mode == imwPPhi2IsZero {
+		xb = lp.Lam
+		yb = Q.c2
+	} else {
+		t := lp.Lam * Q.sphi2
+		xb = Q.r2 * math.Sin(t)
+		yb = Q.c2 + Q.r2*(1.-math.Cos(t))
+	}
+	if Q.mode == imwPPhi1IsZero {
+		xc = lp.Lam
+		yc = 0.
+	} else {
+		t := lp.Lam * Q.sphi1
+		xc = Q.r1 * math.Sin(t)
+		yc = Q.r1 * (1. - math.Cos(t))
+	}
+	D := (xb - xc) / (yb - yc)
+	B := xc + D*(C+R-yc)
+	r = R*R*(1+D*D) - B*B
+	if r < 0.0 {
+		return nil, yc, merror.New(merror.ToleranceCondition)
+	}
+	xy.X = D * math.Sqrt(r)
+	if lp.Phi > 0 {
+		xy.X = -xy.X
+	}
+	xy.X = (B + xy.X) / (1. + D*D)
+	r = R*R - xy.X*xy.X
+	if r < 0.0 {
+		return nil, yc, merror.New(merror.ToleranceCondition)
+	}
+	xy.Y = math.Sqrt(r)
+	if lp.Phi > 0 {
+		xy.Y = -xy.Y
+	}
+	xy.Y += C + R
+	return xy, yc, nil
+}
+
+func (op *ImwP) xy(phi float64) (x, y, sp, R float64) {
+	PE := op.System.Ellipsoid
+
+	sp = math.Sin(phi)
+	R = 1. / (math.Tan(phi) * math.Sqrt(1.-PE.Es*sp*sp))
+	F := op.lam1 * sp
+	y = R * (1 - math.Cos(F))
+	x = R * math.Sin(F)
+	return
+}
+
+func (op *ImwP) setup(sys *core.System) error {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	Q.en = support.Enfn(PE.Es)
+
+	if !ps.ContainsKey("lat_1") || !ps.ContainsKey("lat_2") {
+		return merror.New(merror.Lat1OrLat2Missing)
+	}
+	lat1, _ := ps.GetAsFloat("lat_1")
+	lat2, _ := ps.GetAsFloat("lat_2")
+	Q.phi1 = support.DDToR(lat1)
+	Q.phi2 = support.DDToR(lat2)
+	del := 0.5 * (Q.phi2 - Q.phi1)
+	sig := 0.5 * (Q.phi2 + Q.phi1)
+	if math.Abs(del) < eps10 || math.Abs(sig) < eps10 {
+		return merror.New(merror.InvalidLat1OrLat2)
+	}
+
+	if Q.phi2 < Q.phi1 { // make sure phi1 most southerly
+		Q.phi1, Q.phi2 = Q.phi2, Q.phi1
+	}
+	if ps.ContainsKey("lon_1") {
+		lon1, _ := ps.GetAsFloat("lon_1")
+		Q.lam1 = support.DDToR(lon1)
+	} else { // use predefined based upon latitude
+		sig = math.Abs(support.RToDD(sig))
+		if sig <= 60 {
+			sig = 2.
+		} else if sig <= 76 {
+			sig = 4.
+		} else {
+			sig = 8.
+		}
+		Q.lam1 = support.DDToR(sig)
+	}
+
+	var x1, y1, x2, T2 float64
+	Q.mode = imwPNoneIsZero
+	if Q.phi1 != 0.0 {
+		x1, y1, Q.sphi1, Q.r1 = Q.xy(Q.phi1)
+	} else {
+		Q.mode = imwPPhi1IsZero
+		y1 = 0.
+		x1 = Q.lam1
+	}
+	if Q.phi2 != 0.0 {
+		x2, T2, Q.sphi2, Q.r2 = Q.xy(Q.phi2)
+	} else {
+		Q.mode = imwPPhi2IsZero
+		T2 = 0.
+		x2 = Q.lam1
+	}
+	m1 := support.Mlfn(Q.phi1, Q.sphi1, math.Cos(Q.phi1), Q.en)
+	m2 := support.Mlfn(Q.phi2, Q.sphi2, math.Cos(Q.phi2), Q.en)
+	t := m2 - m1
+	s := x2 - x1
+	y2 := math.Sqrt(t*t-s*s) + y1
+	Q.c2 = y2 - T2
+	t = 1. / t
+	Q.p = (m2*y1 - m1*y2) * t
+	Q.q = (y2 - y1) * t
+	Q.pp = (m2*x1 - m1*x2) * t
+	Q.qp = (x2 - x1) * t
+
+	return nil
+}
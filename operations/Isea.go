@@ -0,0 +1,473 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("isea",
+		"Icosahedral Snyder Equal Area",
+		"\n\tSph",
+		NewIsea,
+	)
+}
+
+// standard ISEA orientation: one vertex at (11.25E, 58.28252559N),
+// with the icosahedron symmetric about the equator
+const iseaStdLat = 1.01722196792335072101
+const iseaStdLon = .19634954084936207740
+
+// latitude of the upper and lower rings of vertices, 26.565051177 degrees
+const iseaVLat = 0.46364760899944494524
+
+// latitudes of the triangle centers, 52.62263186 and 10.81231696 degrees
+const iseaERad = 0.91843818702186776133
+const iseaFRad = 0.18871053072122403508
+
+// layout of the triangles in the plane: R tan(g) sin(60) and 0.25 R tan(g)
+const iseaTableG = 0.6615845383
+const iseaTableH = 0.1909830056
+
+// Snyder's R', the radius of the sphere scaled to the icosahedron
+const iseaRPrime = 0.91038328153090290025
+
+// the icosahedron face constants from Snyder, in degrees: g is the
+// spherical distance from the center of a face to its vertices, G the
+// spherical angle between the radius to a vertex and the adjacent edge,
+// and theta the plane angle between them
+const iseaG = 37.37736814
+const iseaBigG = 36.0
+const iseaTheta = 30.0
+
+const iseaDeg36 = 0.62831853071795864768
+const iseaDeg72 = 1.25663706143591729537
+const iseaDeg108 = 1.88495559215387594306
+const iseaDeg120 = 2.09439510239319549229
+const iseaDeg144 = 2.51327412287183459075
+
+const iseaTol = 0.000005
+const iseaMaxIter = 20
+const iseaLoopTol = 1e-12
+
+// the twelve vertices of the icosahedron, as {lon, lat}
+var iseaVertex = [12][2]float64{
+	{0.0, support.PiOverTwo},
+	{math.Pi, iseaVLat},
+	{-iseaDeg108, iseaVLat},
+	{-iseaDeg36, iseaVLat},
+	{iseaDeg36, iseaVLat},
+	{iseaDeg108, iseaVLat},
+	{-iseaDeg144, -iseaVLat},
+	{-iseaDeg72, -iseaVLat},
+	{0.0, -iseaVLat},
+	{iseaDeg72, -iseaVLat},
+	{iseaDeg144, -iseaVLat},
+	{0.0, -support.PiOverTwo},
+}
+
+// the vertex each triangle's azimuths are measured from
+var iseaTriV1 = [21]int{0, 0, 0, 0, 0, 0, 6, 7, 8, 9, 10, 2, 3, 4, 5, 1, 11, 11, 11, 11, 11}
+
+// the centers of the twenty triangles, as {lon, lat}; index 0 is unused
+var iseaTriangleCenter = [21][2]float64{
+	{0.0, 0.0},
+	{-iseaDeg144, iseaERad},
+	{-iseaDeg72, iseaERad},
+	{0.0, iseaERad},
+	{iseaDeg72, iseaERad},
+	{iseaDeg144, iseaERad},
+	{-iseaDeg144, iseaFRad},
+	{-iseaDeg72, iseaFRad},
+	{0.0, iseaFRad},
+	{iseaDeg72, iseaFRad},
+	{iseaDeg144, iseaFRad},
+	{-iseaDeg108, -iseaFRad},
+	{-iseaDeg36, -iseaFRad},
+	{iseaDeg36, -iseaFRad},
+	{iseaDeg108, -iseaFRad},
+	{math.Pi, -iseaFRad},
+	{-iseaDeg108, -iseaERad},
+	{-iseaDeg36, -iseaERad},
+	{iseaDeg36, -iseaERad},
+	{iseaDeg108, -iseaERad},
+	{math.Pi, -iseaERad},
+}
+
+// Isea implements core.IOperation and core.ConvertLPToXY
+//
+// The sphere is mapped onto the twenty faces of an icosahedron using
+// Snyder's equal area polyhedral projection, and the faces are then
+// unfolded into a strip in the plane. The orientation of the
+// icosahedron is given by lat_0 and lon_0, the position of its
+// reference vertex, and azi, the rotation about it.
+type Isea struct {
+	core.Operation
+	oLat float64
+	oLon float64
+	oAz  float64
+
+	g        float64
+	bigG     float64
+	cotTheta float64
+	tanG     float64
+}
+
+// NewIsea returns a new Isea
+func NewIsea(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Isea{}
+	op.System = system
+
+	err := op.setup()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// TriangleOf returns the icosahedron face, from 1 to 20, that the point
+// lp falls on.
+func (op *Isea) TriangleOf(lp *core.CoordLP) int {
+	lat, lon := op.rotate(lp.Phi, lp.Lam)
+	tri, _, _ := op.snyderForward(lat, lon)
+	return tri
+}
+
+// Forward goes forewards
+func (op *Isea) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	lat, lon := op.rotate(lp.Phi, lp.Lam)
+
+	tri, x, y := op.snyderForward(lat, lon)
+	if tri == 0 {
+		return nil, merror.New(merror.CoordinateError)
+	}
+
+	// unfold the triangle into the plane
+	if iseaDownTri(tri) {
+		x, y = -x, -y
+	}
+	cx, cy := iseaTriangleXY(tri)
+
+	xy := &core.CoordXY{X: x + cx, Y: y + cy}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Isea) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	for _, tol := range []float64{0.0, iseaTol} {
+		lp, err := op.inverseTol(xy, tol)
+		if lp != nil || err != nil {
+			return lp, err
+		}
+	}
+	return nil, merror.New(merror.InvalidXOrY)
+}
+
+// inverseTol looks for the triangle holding xy, to within a relative
+// tolerance, and takes the point back to the sphere. It returns nil if
+// no triangle holds the point.
+func (op *Isea) inverseTol(xy *core.CoordXY, tol float64) (*core.CoordLP, error) {
+	maxD := iseaRPrime * op.tanG
+
+	for tri := 1; tri <= 20; tri++ {
+		cx, cy := iseaTriangleXY(tri)
+		x, y := xy.X-cx, xy.Y-cy
+		if iseaDownTri(tri) {
+			x, y = -x, -y
+		}
+
+		rho := math.Hypot(x, y)
+		if rho > maxD*(1.+tol) {
+			continue
+		}
+
+		// reduce the azimuth into the 0 to 120 degree sector, noting
+		// the adjustment so we can put it back on the sphere
+		azPrime := math.Atan2(x, y)
+		if azPrime < 0.0 {
+			azPrime += support.TwoPi
+		}
+		adjust := 0
+		for azPrime > iseaDeg120+eps10 {
+			azPrime -= iseaDeg120
+			adjust++
+		}
+
+		dPrime := maxD / (math.Cos(azPrime) + math.Sin(azPrime)*op.cotTheta)
+		if rho > dPrime*(1.+tol) {
+			continue
+		}
+
+		lat, lon, err := op.snyderInverse(tri, azPrime, adjust, rho, dPrime)
+		if err != nil {
+			return nil, err
+		}
+		lat, lon = op.unrotate(lat, lon)
+		return &core.CoordLP{Lam: lon, Phi: lat}, nil
+	}
+
+	return nil, nil
+}
+
+// snyderForward finds the triangle the (rotated) point falls on, and
+// returns its number along with the point's coordinates relative to
+// the center of the triangle. The triangle is zero if the point falls
+// on none of them, which should not happen.
+func (op *Isea) snyderForward(lat, lon float64) (int, float64, float64) {
+
+	// points within the tolerance of an edge could belong to either
+	// triangle, so look for an exact match before a close one
+	for _, tol := range []float64{0.0, iseaTol} {
+		tri, x, y := op.snyderForwardTol(lat, lon, tol)
+		if tri != 0 {
+			return tri, x, y
+		}
+	}
+	return 0, 0.0, 0.0
+}
+
+func (op *Isea) snyderForwardTol(lat, lon, tol float64) (int, float64, float64) {
+
+	for i := 1; i <= 20; i++ {
+		cLon, cLat := iseaTriangleCenter[i][0], iseaTriangleCenter[i][1]
+
+		// step 1
+		z := math.Acos(math.Sin(cLat)*math.Sin(lat) +
+			math.Cos(cLat)*math.Cos(lat)*math.Cos(lon-cLon))
+		if z > op.g+tol {
+			continue
+		}
+
+		Az := iseaAzimuth(cLon, cLat, lon, lat)
+
+		// step 2: measure the azimuth from the triangle's vertex, and
+		// reduce it into the 0 to 120 degree sector
+		Az -= iseaAzAdjustment(i)
+		if Az < 0.0 {
+			Az += support.TwoPi
+		}
+		adjust := 0
+		for Az < 0.0 {
+			Az += iseaDeg120
+			adjust--
+		}
+		for Az > iseaDeg120+eps10 {
+			Az -= iseaDeg120
+			adjust++
+		}
+
+		// step 3
+		q := math.Atan2(op.tanG, math.Cos(Az)+math.Sin(Az)*op.cotTheta)
+		if z > q+tol {
+			continue
+		}
+
+		// step 4: eqs 6-8 and 10-12
+		H := math.Acos(math.Sin(Az)*math.Sin(op.bigG)*math.Cos(op.g) -
+			math.Cos(Az)*math.Cos(op.bigG))
+		Ag := Az + op.bigG + H - math.Pi
+		AzPrime := math.Atan2(2.0*Ag,
+			iseaRPrime*iseaRPrime*op.tanG*op.tanG-2.0*Ag*op.cotTheta)
+		dPrime := iseaRPrime * op.tanG /
+			(math.Cos(AzPrime) + math.Sin(AzPrime)*op.cotTheta)
+		f := dPrime / (2.0 * iseaRPrime * math.Sin(q/2.0))
+		rho := 2.0 * iseaRPrime * f * math.Sin(z/2.0)
+
+		AzPrime += iseaDeg120 * float64(adjust)
+
+		return i, rho * math.Sin(AzPrime), rho * math.Cos(AzPrime)
+	}
+
+	return 0, 0.0, 0.0
+}
+
+// snyderInverse takes a point on triangle tri, given by its reduced plane
+// azimuth and distance from the triangle center, back to the (rotated)
+// sphere
+func (op *Isea) snyderInverse(tri int, azPrime float64, adjust int, rho, dPrime float64) (float64, float64, error) {
+	cLon, cLat := iseaTriangleCenter[tri][0], iseaTriangleCenter[tri][1]
+
+	if rho < eps10 {
+		return cLat, cLon, nil
+	}
+
+	// invert eq 8 for the area of the spherical sub-triangle
+	tanAz := math.Tan(azPrime)
+	rt2 := iseaRPrime * iseaRPrime * op.tanG * op.tanG
+	var Ag float64
+	if math.Abs(azPrime-support.PiOverTwo) < eps10 {
+		Ag = rt2 / (2.0 * op.cotTheta)
+	} else {
+		Ag = rt2 * tanAz / (2.0 * (1.0 + tanAz*op.cotTheta))
+	}
+
+	// solve eqs 6 and 7 for the spherical azimuth by Newton's method
+	Az := azPrime
+	converged := false
+	for i := iseaMaxIter; i > 0; i-- {
+		sinAz, cosAz := math.Sin(Az), math.Cos(Az)
+		cosH := sinAz*math.Sin(op.bigG)*math.Cos(op.g) - cosAz*math.Cos(op.bigG)
+		H := math.Acos(cosH)
+		sinH := math.Sin(H)
+		fAz := Az + op.bigG + H - math.Pi - Ag
+		dfAz := 1.0
+		if sinH > eps10 {
+			dfAz -= (cosAz*math.Sin(op.bigG)*math.Cos(op.g) + sinAz*math.Cos(op.bigG)) / sinH
+		}
+		delta := fAz / dfAz
+		Az -= delta
+		if math.Abs(delta) < iseaLoopTol {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return 0.0, 0.0, merror.New(merror.NonConvergent)
+	}
+
+	// eqs 9, 11 and 12 for the spherical distance from the center
+	q := math.Atan2(op.tanG, math.Cos(Az)+math.Sin(Az)*op.cotTheta)
+	f := dPrime / (2.0 * iseaRPrime * math.Sin(q/2.0))
+	z := 2.0 * support.Aasin(rho/(2.0*iseaRPrime*f))
+
+	Az += iseaDeg120*float64(adjust) + iseaAzAdjustment(tri)
+
+	// walk the distance z along azimuth Az from the center
+	sinLat := math.Sin(cLat)*math.Cos(z) + math.Cos(cLat)*math.Sin(z)*math.Cos(Az)
+	lat := support.Aasin(sinLat)
+	lon := cLon + math.Atan2(math.Sin(Az)*math.Sin(z)*math.Cos(cLat),
+		math.Cos(z)-math.Sin(cLat)*sinLat)
+	return lat, support.Adjlon(lon), nil
+}
+
+// rotate takes a point to the coordinate system of the icosahedron,
+// with the reference vertex at the north pole (Snyder, Map Projections:
+// A Working Manual, eqs 5-7 and 5-8b)
+func (op *Isea) rotate(lat, lon float64) (float64, float64) {
+	dlon := lon - op.oLon
+	sinA, cosA := math.Sin(op.oLat), math.Cos(op.oLat)
+
+	sinLatP := sinA*math.Sin(lat) + cosA*math.Cos(lat)*math.Cos(dlon)
+	lonP := math.Atan2(math.Cos(lat)*math.Sin(dlon),
+		sinA*math.Cos(lat)*math.Cos(dlon)-cosA*math.Sin(lat))
+
+	lonP = support.Adjlon(lonP + op.oAz)
+	return support.Aasin(sinLatP), lonP
+}
+
+// unrotate is the inverse of rotate
+func (op *Isea) unrotate(latP, lonP float64) (float64, float64) {
+	dlonP := lonP - op.oAz
+	sinA, cosA := math.Sin(op.oLat), math.Cos(op.oLat)
+
+	sinLat := sinA*math.Sin(latP) - cosA*math.Cos(latP)*math.Cos(dlonP)
+	dlon := math.Atan2(math.Cos(latP)*math.Sin(dlonP),
+		sinA*math.Cos(latP)*math.Cos(dlonP)+cosA*math.Sin(latP))
+
+	return support.Aasin(sinLat), support.Adjlon(dlon + op.oLon)
+}
+
+// iseaAzimuth returns the azimuth of (tLon, tLat) from (fLon, fLat)
+// (Snyder eq 14)
+func iseaAzimuth(fLon, fLat, tLon, tLat float64) float64 {
+	return math.Atan2(math.Cos(tLat)*math.Sin(tLon-fLon),
+		math.Cos(fLat)*math.Sin(tLat)-
+			math.Sin(fLat)*math.Cos(tLat)*math.Cos(tLon-fLon))
+}
+
+// iseaAzAdjustment returns the azimuth of a triangle's reference vertex
+// as seen from its center
+func iseaAzAdjustment(tri int) float64 {
+	v := iseaVertex[iseaTriV1[tri]]
+	c := iseaTriangleCenter[tri]
+	return iseaAzimuth(c[0], c[1], v[0], v[1])
+}
+
+// iseaDownTri reports whether the triangle points down in the plane
+func iseaDownTri(tri int) bool {
+	return ((tri-1)/5)%2 == 1
+}
+
+// iseaTriangleXY returns the center of the triangle in the plane
+func iseaTriangleXY(tri int) (float64, float64) {
+	tri = (tri - 1) % 20
+
+	x := iseaTableG * float64((tri%5)-2) * 2.0
+	if tri > 9 {
+		x += iseaTableG
+	}
+
+	var y float64
+	switch tri / 5 {
+	case 0:
+		y = 5.0 * iseaTableH
+	case 1:
+		y = iseaTableH
+	case 2:
+		y = -iseaTableH
+	case 3:
+		y = -5.0 * iseaTableH
+	}
+
+	return x * iseaRPrime, y * iseaRPrime
+}
+
+func (op *Isea) setup() error {
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	op.oLat = iseaStdLat
+	op.oLon = iseaStdLon
+	op.oAz = 0.0
+
+	orient, ok := ps.GetAsString("orient")
+	if ok {
+		switch orient {
+		case "isea":
+		case "pole":
+			op.oLat = support.PiOverTwo
+			op.oLon = 0.0
+		default:
+			return merror.New(merror.InvalidOrient)
+		}
+	}
+
+	mode, ok := ps.GetAsString("mode")
+	if ok && mode != "plane" {
+		return merror.New(merror.InvalidMode)
+	}
+
+	if azi, ok := ps.GetAsFloat("azi"); ok {
+		op.oAz = support.DDToR(azi)
+	}
+	if ps.ContainsKey("lon_0") {
+		op.oLon = P.Lam0
+	}
+	if ps.ContainsKey("lat_0") {
+		op.oLat = P.Phi0
+	}
+
+	// lat_0 and lon_0 orient the icosahedron, rather than being
+	// applied to the coordinates as well
+	P.Lam0 = 0.0
+	P.Phi0 = 0.0
+	PE.Es = 0.0
+
+	op.g = support.DDToR(iseaG)
+	op.bigG = support.DDToR(iseaBigG)
+	op.cotTheta = 1.0 / math.Tan(support.DDToR(iseaTheta))
+	op.tanG = math.Tan(op.g)
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("krovak",
+		"Krovak",
+		"\n\tPCyl, Ell\n\tczech (south/west axes, default is east/north) alpha= lat_ts=",
+		NewKrovak,
+	)
+}
+
+const (
+	krovakEps     = 1e-15
+	krovakUQ      = 1.04216856380474 /* DU(2, 59, 42, 42.69689) */
+	krovakS0      = 1.37008346281555 /* Latitude of pseudo standard parallel 78deg 30'00" N */
+	krovakMaxIter = 100
+)
+
+// Krovak implements core.IOperation and core.ConvertLPToXY
+//
+// The Krovak projection is the oblique conformal conic used by the
+// S-JTSK system in the Czech Republic and Slovakia. Points are first
+// mapped onto the Gaussian sphere, then rotated onto an oblique
+// graticule whose pole is the cone axis, and finally projected onto
+// a conic touching the pseudo standard parallel.
+//
+// There is no separate switch for the north-oriented variant, because
+// it is the default: x is easting and y is northing, as in EPSG:5514
+// (S-JTSK / Krovak East North), which gives negative values over the
+// whole territory. +czech selects the classical south/west oriented
+// axes instead (westing and southing, positive over the territory):
+// the same numbers with both signs flipped.
+type Krovak struct {
+	core.Operation
+	alpha float64
+	k     float64
+	n     float64
+	rho0  float64
+	ad    float64
+	s0    float64
+	czech float64
+}
+
+// NewKrovak returns a new Krovak
+func NewKrovak(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Krovak{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Krovak) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	gfi := math.Pow((1.+PE.E*math.Sin(lp.Phi))/(1.-PE.E*math.Sin(lp.Phi)), Q.alpha*PE.E/2.)
+
+	u := 2. * (math.Atan(Q.k*math.Pow(math.Tan(lp.Phi/2.+support.PiOverFour), Q.alpha)/gfi) - support.PiOverFour)
+	deltav := -lp.Lam * Q.alpha
+
+	s := math.Asin(math.Cos(Q.ad)*math.Sin(u) + math.Sin(Q.ad)*math.Cos(u)*math.Cos(deltav))
+	cosS := math.Cos(s)
+	if cosS < 1e-12 {
+		return xy, nil
+	}
+	d := math.Asin(math.Cos(u) * math.Sin(deltav) / cosS)
+
+	eps := Q.n * d
+	rho := Q.rho0 * math.Pow(math.Tan(Q.s0/2.+support.PiOverFour), Q.n) / math.Pow(math.Tan(s/2.+support.PiOverFour), Q.n)
+
+	xy.Y = rho * math.Cos(eps) * Q.czech
+	xy.X = rho * math.Sin(eps) * Q.czech
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Krovak) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	x := xy.Y * Q.czech
+	y := xy.X * Q.czech
+
+	rho := math.Sqrt(x*x + y*y)
+	eps := math.Atan2(y, x)
+
+	d := eps / math.Sin(Q.s0)
+	var s float64
+	if rho == 0.0 {
+		s = support.PiOverTwo
+	} else {
+		s = 2. * (math.Atan(math.Pow(Q.rho0/rho, 1./Q.n)*math.Tan(Q.s0/2.+support.PiOverFour)) - support.PiOverFour)
+	}
+
+	u := math.Asin(math.Cos(Q.ad)*math.Sin(s) - math.Sin(Q.ad)*math.Cos(s)*math.Cos(d))
+	deltav := math.Asin(math.Cos(s) * math.Sin(d) / math.Cos(u))
+
+	lp.Lam = -deltav / Q.alpha
+
+	/* ITERATION FOR lp.phi */
+	fi1 := u
+	i := krovakMaxIter
+	for ; i > 0; i-- {
+		lp.Phi = 2. * (math.Atan(math.Pow(Q.k, -1./Q.alpha)*
+			math.Pow(math.Tan(u/2.+support.PiOverFour), 1./Q.alpha)*
+			math.Pow((1.+PE.E*math.Sin(fi1))/(1.-PE.E*math.Sin(fi1)), PE.E/2.)) - support.PiOverFour)
+
+		if math.Abs(fi1-lp.Phi) < krovakEps {
+			break
+		}
+		fi1 = lp.Phi
+	}
+	if i == 0 {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	return lp, nil
+}
+
+func (op *Krovak) setup(sys *core.System) error {
+	Q := op
+	P := op.System
+	PE := op.System.Ellipsoid
+	ps := P.ProjString
+
+	/* we want Bessel as fixed ellipsoid */
+	PE.A = 6377397.155
+	PE.Ra = 1. / PE.A
+	PE.Es = 0.006674372230614
+	PE.E = math.Sqrt(PE.Es)
+	PE.OneEs = 1. - PE.Es
+	PE.ROneEs = 1. / PE.OneEs
+
+	/* if latitude of projection center is not set, use 49d30'N */
+	if !ps.ContainsKey("lat_0") {
+		P.Phi0 = 0.863937979737193
+	}
+
+	/* if center long is not set use 42d30'E of Ferro - 17d40' for Ferro */
+	/* that will correspond to using longitudes relative to greenwich    */
+	/* as input and output, instead of lat/long relative to Ferro */
+	if !ps.ContainsKey("lon_0") {
+		P.Lam0 = 0.7417649320975901 - 0.308341501185665
+	}
+
+	/* if scale not set default to 0.9999 */
+	if !ps.ContainsKey("k") && !ps.ContainsKey("k_0") {
+		P.K0 = 0.9999
+	}
+
+	/* co-latitude of the cone axis, default 30d17'17.30311" */
+	Q.ad = support.PiOverTwo - krovakUQ
+	if ps.ContainsKey("alpha") {
+		alpha, _ := ps.GetAsFloat("alpha")
+		Q.ad = support.DDToR(alpha)
+	}
+
+	/* latitude of the pseudo standard parallel, default 78d30'N */
+	Q.s0 = krovakS0
+	if ps.ContainsKey("lat_ts") {
+		latts, _ := ps.GetAsFloat("lat_ts")
+		Q.s0 = support.DDToR(latts)
+		if math.Abs(Q.s0) >= support.PiOverTwo || Q.s0 == 0.0 {
+			return merror.New(merror.LatTSLargerThan90)
+		}
+	}
+
+	Q.czech = -1
+	if ps.ContainsKey("czech") {
+		Q.czech = 1
+	}
+
+	/* Set up shared parameters between forward and inverse */
+	Q.alpha = math.Sqrt(1. + (PE.Es*math.Pow(math.Cos(P.Phi0), 4))/(1.-PE.Es))
+	u0 := math.Asin(math.Sin(P.Phi0) / Q.alpha)
+	g := math.Pow((1.+PE.E*math.Sin(P.Phi0))/(1.-PE.E*math.Sin(P.Phi0)), Q.alpha*PE.E/2.)
+	tanHalfPhi0PlusPi4 := math.Tan(P.Phi0/2. + support.PiOverFour)
+	if tanHalfPhi0PlusPi4 == 0.0 {
+		return merror.New(merror.InvalidArg)
+	}
+	Q.k = math.Tan(u0/2.+support.PiOverFour) / math.Pow(tanHalfPhi0PlusPi4, Q.alpha) * g
+	n0 := math.Sqrt(1.-PE.Es) / (1. - PE.Es*math.Pow(math.Sin(P.Phi0), 2))
+	Q.n = math.Sin(Q.s0)
+	Q.rho0 = P.K0 * n0 / math.Tan(Q.s0)
+
+	return nil
+}
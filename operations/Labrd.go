@@ -0,0 +1,170 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("labrd",
+		"Laborde",
+		"\n\tCyl, Sph\n\tSpecial for Madagascar",
+		NewLabrd,
+	)
+}
+
+const labrdEps = 1.e-10
+const labrdNIter = 20
+
+// Labrd implements core.IOperation and core.ConvertLPToXY
+type Labrd struct {
+	core.Operation
+	kRg float64
+	p0s float64
+	A   float64
+	C   float64
+	Ca  float64
+	Cb  float64
+	Cc  float64
+	Cd  float64
+}
+
+// NewLabrd returns a new Labrd
+func NewLabrd(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Labrd{}
+	op.System = system
+
+	err := op.setup()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Labrd) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	V1 := Q.A * math.Log(math.Tan(support.PiOverFour+.5*lp.Phi))
+	t := PE.E * math.Sin(lp.Phi)
+	V2 := .5 * PE.E * Q.A * math.Log((1.+t)/(1.-t))
+	ps := 2. * (math.Atan(math.Exp(V1-V2+Q.C)) - support.PiOverFour)
+	I1 := ps - Q.p0s
+	cosps := math.Cos(ps)
+	cosps2 := cosps * cosps
+	sinps := math.Sin(ps)
+	sinps2 := sinps * sinps
+	I4 := Q.A * cosps
+	I2 := .5 * Q.A * I4 * sinps
+	I3 := I2 * Q.A * Q.A * (5.*cosps2 - sinps2) / 12.
+	I6 := I4 * Q.A * Q.A
+	I5 := I6 * (cosps2 - sinps2) / 6.
+	I6 *= Q.A * Q.A *
+		(5.*cosps2*cosps2 + sinps2*(sinps2-18.*cosps2)) / 120.
+	t = lp.Lam * lp.Lam
+	xy.X = Q.kRg * lp.Lam * (I4 + t*(I5+t*I6))
+	xy.Y = Q.kRg * (I1 + t*(I2+t*I3))
+	x2 := xy.X * xy.X
+	y2 := xy.Y * xy.Y
+	V1 = 3.*xy.X*y2 - xy.X*x2
+	V2 = xy.Y*y2 - 3.*x2*xy.Y
+	xy.X += Q.Ca*V1 + Q.Cb*V2
+	xy.Y += Q.Ca*V2 - Q.Cb*V1
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Labrd) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+
+	x2 := xy.X * xy.X
+	y2 := xy.Y * xy.Y
+	V1 := 3.*xy.X*y2 - xy.X*x2
+	V2 := xy.Y*y2 - 3.*x2*xy.Y
+	V3 := xy.X * (5.*y2*y2 + x2*(-10.*y2+x2))
+	V4 := xy.Y * (5.*x2*x2 + y2*(-10.*x2+y2))
+	xy.X += -Q.Ca*V1 - Q.Cb*V2 + Q.Cc*V3 + Q.Cd*V4
+	xy.Y += Q.Cb*V1 - Q.Ca*V2 - Q.Cd*V3 + Q.Cc*V4
+	ps := Q.p0s + xy.Y/Q.kRg
+	pe := ps + P.Phi0 - Q.p0s
+
+	for i := labrdNIter; i > 0; i-- {
+		V1 = Q.A * math.Log(math.Tan(support.PiOverFour+.5*pe))
+		tpe := PE.E * math.Sin(pe)
+		V2 = .5 * PE.E * Q.A * math.Log((1.+tpe)/(1.-tpe))
+		t := ps - 2.*(math.Atan(math.Exp(V1-V2+Q.C))-support.PiOverFour)
+		pe += t
+		if math.Abs(t) < labrdEps {
+			break
+		}
+	}
+
+	t := PE.E * math.Sin(pe)
+	t = 1. - t*t
+	Re := PE.OneEs / (t * math.Sqrt(t))
+	t = math.Tan(ps)
+	t2 := t * t
+	s := Q.kRg * Q.kRg
+	d := Re * P.K0 * Q.kRg
+	I7 := t / (2. * d)
+	I8 := t * (5. + 3.*t2) / (24. * d * s)
+	d = math.Cos(ps) * Q.kRg * Q.A
+	I9 := 1. / d
+	d *= s
+	I10 := (1. + 2.*t2) / (6. * d)
+	I11 := (5. + t2*(28.+24.*t2)) / (120. * d * s)
+	x2 = xy.X * xy.X
+	lp.Phi = pe + x2*(-I7+I8*x2)
+	lp.Lam = xy.X * (I9 + x2*(-I10+x2*I11))
+	return lp, nil
+}
+
+func (op *Labrd) setup() error {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+
+	if P.Phi0 == 0. {
+		return merror.New(merror.Lat0IsZero)
+	}
+
+	Az, _ := P.ProjString.GetAsFloat("azi")
+	Az = support.DDToR(Az)
+
+	sinp := math.Sin(P.Phi0)
+	t := 1. - PE.Es*sinp*sinp
+	N := 1. / math.Sqrt(t)
+	R := PE.OneEs * N / t
+	Q.kRg = P.K0 * math.Sqrt(N*R)
+	Q.p0s = math.Atan(math.Sqrt(R/N) * math.Tan(P.Phi0))
+	Q.A = sinp / math.Sin(Q.p0s)
+	t = PE.E * sinp
+	Q.C = .5*PE.E*Q.A*math.Log((1.+t)/(1.-t)) -
+		Q.A*math.Log(math.Tan(support.PiOverFour+.5*P.Phi0)) +
+		math.Log(math.Tan(support.PiOverFour+.5*Q.p0s))
+	t = Az + Az
+	Q.Cb = 1. / (12. * Q.kRg * Q.kRg)
+	Q.Ca = (1. - math.Cos(t)) * Q.Cb
+	Q.Cb *= math.Sin(t)
+	Q.Cc = 3. * (Q.Ca*Q.Ca - Q.Cb*Q.Cb)
+	Q.Cd = 6. * Q.Ca * Q.Cb
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("lagrng",
+		"Lagrange",
+		"\n\tMisc Sph\n\tW=",
+		NewLagrng,
+	)
+}
+
+const lagrngTol = 1e-10
+
+// Lagrng implements core.IOperation and core.ConvertLPToXY
+type Lagrng struct {
+	core.Operation
+	a1  float64
+	a2  float64
+	hrw float64
+	hw  float64
+	rw  float64
+	w   float64
+}
+
+// NewLagrng returns a new Lagrng
+func NewLagrng(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Lagrng{}
+	op.System = system
+
+	err := op.lagrngSetup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Lagrng) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < lagrngTol {
+		xy.X = 0
+		if lp.Phi < 0 {
+			xy.Y = -2.
+		} else {
+			xy.Y = 2.
+		}
+		return xy, nil
+	}
+
+	sinphi := math.Sin(lp.Phi)
+	v := Q.a1 * math.Pow((1.+sinphi)/(1.-sinphi), Q.hrw)
+	lam := lp.Lam * Q.rw
+	c := 0.5*(v+1./v) + math.Cos(lam)
+	if c < lagrngTol {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	xy.X = 2. * math.Sin(lam) / c
+	xy.Y = (v - 1./v) / c
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Lagrng) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	if math.Abs(math.Abs(xy.Y)-2.) < lagrngTol {
+		if xy.Y < 0 {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+		lp.Lam = 0
+		return lp, nil
+	}
+
+	x2 := xy.X * xy.X
+	y2p := 2. + xy.Y
+	y2m := 2. - xy.Y
+	c := y2p*y2m - x2
+	if math.Abs(c) < lagrngTol {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	lp.Phi = 2.*math.Atan(math.Pow((y2p*y2p+x2)/(Q.a2*(y2m*y2m+x2)), Q.hw)) - support.PiOverTwo
+	lp.Lam = Q.w * math.Atan2(4.*xy.X, c)
+
+	return lp, nil
+}
+
+func (op *Lagrng) lagrngSetup(sys *core.System) error {
+	Q := op
+	PE := op.System.Ellipsoid
+	ps := sys.ProjString
+
+	Q.w = 2.0
+	if ps.ContainsKey("W") {
+		Q.w, _ = ps.GetAsFloat("W")
+	}
+	if Q.w <= 0 {
+		return merror.New(merror.WOrMZeroOrLess)
+	}
+	Q.hw = 0.5 * Q.w
+	Q.rw = 1. / Q.w
+	Q.hrw = 0.5 * Q.rw
+
+	phi1, _ := ps.GetAsFloat("lat_1")
+	phi1 = math.Sin(support.DDToR(phi1))
+	if math.Abs(math.Abs(phi1)-1.) < lagrngTol {
+		return merror.New(merror.LatTSLargerThan90)
+	}
+	Q.a1 = math.Pow((1.-phi1)/(1.+phi1), Q.hrw)
+	Q.a2 = Q.a1 * Q.a1
+
+	PE.Es = 0.
+
+	return nil
+}
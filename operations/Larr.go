@@ -0,0 +1,56 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("larr",
+		"Larrivee",
+		"\n\tMisc Sph, no inv.",
+		NewLarr,
+	)
+}
+
+const larrSixth = .16666666666666666
+
+// Larr implements core.IOperation and core.ConvertLPToXY
+type Larr struct {
+	core.Operation
+}
+
+// NewLarr returns a new Larr
+func NewLarr(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Larr{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Larr) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = 0.5 * lp.Lam * (1. + math.Sqrt(math.Cos(lp.Phi)))
+	xy.Y = lp.Phi / (math.Cos(0.5*lp.Phi) * math.Cos(larrSixth*lp.Lam))
+
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Larr) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("lask",
+		"Laskowski",
+		"\n\tMisc Sph, no inv.",
+		NewLask,
+	)
+}
+
+const (
+	laskA10 = 0.975534
+	laskA12 = -0.119161
+	laskA32 = -0.0143059
+	laskA14 = -0.0547009
+	laskB01 = 1.00384
+	laskB21 = 0.0802894
+	laskB03 = 0.0998909
+	laskB41 = 0.000199025
+	laskB23 = -0.0285500
+	laskB05 = -0.0491032
+)
+
+// Lask implements core.IOperation and core.ConvertLPToXY
+type Lask struct {
+	core.Operation
+}
+
+// NewLask returns a new Lask
+func NewLask(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Lask{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Lask) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	l2 := lp.Lam * lp.Lam
+	p2 := lp.Phi * lp.Phi
+	xy.X = lp.Lam * (laskA10 + p2*(laskA12+l2*laskA32+p2*laskA14))
+	xy.Y = lp.Phi * (laskB01 + l2*(laskB21+p2*laskB23+l2*laskB41) +
+		p2*(laskB03+p2*laskB05))
+
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Lask) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
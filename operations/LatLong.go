@@ -0,0 +1,71 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("lonlat",
+		"Lat/long (Geodetic)",
+		"\n\t",
+		NewLatLong,
+	)
+	core.RegisterConvertLPToXY("latlon",
+		"Lat/long (Geodetic alias)",
+		"\n\t",
+		NewLatLong,
+	)
+	core.RegisterConvertLPToXY("latlong",
+		"Lat/long (Geodetic alias)",
+		"\n\t",
+		NewLatLong,
+	)
+	core.RegisterConvertLPToXY("longlat",
+		"Lat/long (Geodetic alias)",
+		"\n\t",
+		NewLatLong,
+	)
+}
+
+// LatLong implements core.IOperation and core.ConvertLPToXY
+//
+// This isn't really a projection at all: the coordinates are passed
+// through unchanged, in radians, once the usual prime meridian and
+// central meridian handling has been applied.
+type LatLong struct {
+	core.Operation
+}
+
+// NewLatLong returns a new LatLong
+func NewLatLong(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &LatLong{}
+	op.System = system
+
+	P := op.System
+	P.IsLatLong = true
+	P.X0 = 0.0
+	P.Y0 = 0.0
+	P.Left = core.IOUnitsAngular
+	P.Right = core.IOUnitsAngular
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *LatLong) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: lp.Lam, Y: lp.Phi}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *LatLong) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: xy.X, Phi: xy.Y}
+	return lp, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("loxim",
+		"Loximuthal",
+		"\n\tPCyl Sph",
+		NewLoxim,
+	)
+}
+
+const loximEps = 1e-8
+
+// Loxim implements core.IOperation and core.ConvertLPToXY
+type Loxim struct {
+	core.Operation
+	phi1    float64
+	cosphi1 float64
+	tanphi1 float64
+}
+
+// NewLoxim returns a new Loxim
+func NewLoxim(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Loxim{}
+	op.System = system
+
+	err := op.loximSetup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Loxim) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.Y = lp.Phi - Q.phi1
+	if math.Abs(xy.Y) < loximEps {
+		xy.X = lp.Lam * Q.cosphi1
+	} else {
+		xy.X = support.PiOverFour + 0.5*lp.Phi
+		if math.Abs(xy.X) < loximEps || math.Abs(math.Abs(xy.X)-support.PiOverTwo) < loximEps {
+			xy.X = 0.
+		} else {
+			xy.X = lp.Lam * xy.Y / math.Log(math.Tan(xy.X)/Q.tanphi1)
+		}
+	}
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Loxim) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y + Q.phi1
+	if math.Abs(xy.Y) < loximEps {
+		lp.Lam = xy.X / Q.cosphi1
+	} else {
+		lp.Lam = support.PiOverFour + 0.5*lp.Phi
+		if math.Abs(lp.Lam) < loximEps || math.Abs(math.Abs(lp.Lam)-support.PiOverTwo) < loximEps {
+			lp.Lam = 0.
+		} else {
+			lp.Lam = xy.X * math.Log(math.Tan(lp.Lam)/Q.tanphi1) / xy.Y
+		}
+	}
+
+	return lp, nil
+}
+
+func (op *Loxim) loximSetup(sys *core.System) error {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	phi1, _ := sys.ProjString.GetAsFloat("lat_1")
+	Q.phi1 = support.DDToR(phi1)
+	Q.cosphi1 = math.Cos(Q.phi1)
+	if Q.cosphi1 < loximEps {
+		return merror.New(merror.LatTSLargerThan90)
+	}
+	Q.tanphi1 = math.Tan(support.PiOverFour + 0.5*Q.phi1)
+
+	PE.Es = 0.
+
+	return nil
+}
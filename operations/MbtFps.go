@@ -0,0 +1,79 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("mbt_fps",
+		"McBryde-Thomas Flat-Pole Sine (No. 2)",
+		"\n\tCyl, Sph",
+		NewMbtFps,
+	)
+}
+
+const mbtFpsMaxIter = 10
+const mbtFpsLoopTol = 1e-7
+const mbtFpsC1 = 0.45503
+const mbtFpsC2 = 1.36509
+const mbtFpsC3 = 1.41546
+const mbtFpsCx = 0.22248
+const mbtFpsCy = 1.44492
+const mbtFpsC12 = 0.33333333333333333333333333
+
+// MbtFps implements core.IOperation and core.ConvertLPToXY
+type MbtFps struct {
+	core.Operation
+}
+
+// NewMbtFps returns a new MbtFps
+func NewMbtFps(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &MbtFps{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *MbtFps) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	k := mbtFpsC3 * math.Sin(lp.Phi)
+	for i := mbtFpsMaxIter; i > 0; i-- {
+		t := lp.Phi / mbtFpsC2
+		V := (mbtFpsC1*math.Sin(t) + math.Sin(lp.Phi) - k) /
+			(mbtFpsC12*math.Cos(t) + math.Cos(lp.Phi))
+		lp.Phi -= V
+		if math.Abs(V) < mbtFpsLoopTol {
+			break
+		}
+	}
+	t := lp.Phi / mbtFpsC2
+	xy.X = mbtFpsCx * lp.Lam * (1. + 3.*math.Cos(lp.Phi)/math.Cos(t))
+	xy.Y = mbtFpsCy * math.Sin(t)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *MbtFps) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	t := support.Aasin(xy.Y / mbtFpsCy)
+	lp.Phi = mbtFpsC2 * t
+	lp.Lam = xy.X / (mbtFpsCx * (1. + 3.*math.Cos(lp.Phi)/math.Cos(t)))
+	lp.Phi = support.Aasin((mbtFpsC1*math.Sin(t) + math.Sin(lp.Phi)) / mbtFpsC3)
+	return lp, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("mbtfpp",
+		"McBride-Thomas Flat-Polar Parabolic",
+		"\n\tCyl, Sph",
+		NewMbtfpp,
+	)
+}
+
+const mbtfppCS = 0.95257934441568037152
+const mbtfppFXC = 0.92582009977255146156
+const mbtfppFYC = 3.40168025708304504493
+const mbtfppC23 = 0.66666666666666666666
+const mbtfppC13 = 0.33333333333333333333
+const mbtfppOneEps = 1.0000001
+
+// Mbtfpp implements core.IOperation and core.ConvertLPToXY
+type Mbtfpp struct {
+	core.Operation
+}
+
+// NewMbtfpp returns a new Mbtfpp
+func NewMbtfpp(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Mbtfpp{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Mbtfpp) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lp.Phi = math.Asin(mbtfppCS * math.Sin(lp.Phi))
+	xy.X = mbtfppFXC * lp.Lam * (2.*math.Cos(mbtfppC23*lp.Phi) - 1.)
+	xy.Y = mbtfppFYC * math.Sin(mbtfppC13*lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Mbtfpp) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	var err error
+	lp.Phi, err = mbtfppAsin(xy.Y / mbtfppFYC)
+	if err != nil {
+		return nil, err
+	}
+	lp.Phi *= 3.
+	lp.Lam = xy.X / (mbtfppFXC * (2.*math.Cos(mbtfppC23*lp.Phi) - 1.))
+	lp.Phi, err = mbtfppAsin(math.Sin(lp.Phi) / mbtfppCS)
+	if err != nil {
+		return nil, err
+	}
+	return lp, nil
+}
+
+// mbtfppAsin is asin, tolerating arguments just past unity
+func mbtfppAsin(v float64) (float64, error) {
+	if math.Abs(v) >= 1. {
+		if math.Abs(v) > mbtfppOneEps {
+			return 0., merror.New(merror.ToleranceCondition)
+		}
+		if v < 0. {
+			return -support.PiOverTwo, nil
+		}
+		return support.PiOverTwo, nil
+	}
+	return math.Asin(v), nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("mbtfpq",
+		"McBryde-Thomas Flat-Polar Quartic",
+		"\n\tCyl, Sph",
+		NewMbtfpq,
+	)
+}
+
+const mbtfpqNIter = 20
+const mbtfpqEps = 1e-7
+const mbtfpqOneTol = 1.000001
+const mbtfpqC = 1.70710678118654752440
+const mbtfpqRC = 0.58578643762690495119
+const mbtfpqFYC = 1.87475828462269495505
+const mbtfpqRYC = 0.53340209679417701685
+const mbtfpqFXC = 0.31245971410378249250
+const mbtfpqRXC = 3.20041258076506210122
+
+// Mbtfpq implements core.IOperation and core.ConvertLPToXY
+type Mbtfpq struct {
+	core.Operation
+}
+
+// NewMbtfpq returns a new Mbtfpq
+func NewMbtfpq(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Mbtfpq{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Mbtfpq) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	c := mbtfpqC * math.Sin(lp.Phi)
+	for i := mbtfpqNIter; i > 0; i-- {
+		th1 := (math.Sin(.5*lp.Phi) + math.Sin(lp.Phi) - c) /
+			(.5*math.Cos(.5*lp.Phi) + math.Cos(lp.Phi))
+		lp.Phi -= th1
+		if math.Abs(th1) < mbtfpqEps {
+			break
+		}
+	}
+	xy.X = mbtfpqFXC * lp.Lam * (1.0 + 2.*math.Cos(lp.Phi)/math.Cos(0.5*lp.Phi))
+	xy.Y = mbtfpqFYC * math.Sin(0.5*lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Mbtfpq) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	var t float64
+	lp.Phi = mbtfpqRYC * xy.Y
+	if math.Abs(lp.Phi) > 1. {
+		if math.Abs(lp.Phi) > mbtfpqOneTol {
+			return nil, merror.New(merror.ToleranceCondition)
+		} else if lp.Phi < 0. {
+			t = -1.
+			lp.Phi = -math.Pi
+		} else {
+			t = 1.
+			lp.Phi = math.Pi
+		}
+	} else {
+		t = lp.Phi
+		lp.Phi = 2. * math.Asin(t)
+	}
+	lp.Lam = mbtfpqRXC * xy.X / (1. + 2.*math.Cos(lp.Phi)/math.Cos(0.5*lp.Phi))
+	lp.Phi = mbtfpqRC * (t + math.Sin(lp.Phi))
+	if math.Abs(lp.Phi) > 1. {
+		if math.Abs(lp.Phi) > mbtfpqOneTol {
+			return nil, merror.New(merror.ToleranceCondition)
+		} else if lp.Phi < 0. {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+	} else {
+		lp.Phi = math.Asin(lp.Phi)
+	}
+	return lp, nil
+}
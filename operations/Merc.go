@@ -21,6 +21,11 @@ func init() {
 		"\n\tCyl, Sph&Ell\n\tlat_ts=",
 		NewMerc,
 	)
+	core.RegisterConvertLPToXY("webmerc",
+		"Web Mercator / Pseudo Mercator",
+		"\n\tCyl, Ell",
+		NewWebMerc,
+	)
 }
 
 // Merc implements core.IOperation and core.ConvertLPToXY
@@ -43,6 +48,20 @@ func NewMerc(system *core.System, desc *core.OperationDescription) (core.IConver
 	return op, nil
 }
 
+// NewWebMerc returns a new Merc which always uses the spherical
+// formulas, with the semi-major axis of the ellipsoid as the radius
+// (EPSG:1024 "Popular Visualisation Pseudo Mercator")
+func NewWebMerc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Merc{
+		isSphere: true,
+	}
+	op.System = system
+
+	op.System.K0 = 1.0
+
+	return op, nil
+}
+
 // Forward goes forewards
 func (op *Merc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
 
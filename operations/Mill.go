@@ -0,0 +1,57 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("mill",
+		"Miller Cylindrical",
+		"\n\tCyl, Sph",
+		NewMill,
+	)
+}
+
+// Mill implements core.IOperation and core.ConvertLPToXY
+type Mill struct {
+	core.Operation
+}
+
+// NewMill returns a new Mill
+func NewMill(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Mill{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Mill) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = lp.Lam
+	xy.Y = math.Log(math.Tan(support.PiOverFour+lp.Phi*.4)) * 1.25
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Mill) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Lam = xy.X
+	lp.Phi = 2.5 * (math.Atan(math.Exp(.8*xy.Y)) - support.PiOverFour)
+	return lp, nil
+}
@@ -0,0 +1,323 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("mil_os",
+		"Miller Oblated Stereographic",
+		"\n\tAzi(mod)",
+		NewMilOs,
+	)
+	core.RegisterConvertLPToXY("lee_os",
+		"Lee Oblated Stereographic",
+		"\n\tAzi(mod)",
+		NewLeeOs,
+	)
+	core.RegisterConvertLPToXY("gs48",
+		"Mod. Stereographic of 48 U.S.",
+		"\n\tAzi(mod)",
+		NewGs48,
+	)
+	core.RegisterConvertLPToXY("alsk",
+		"Mod. Stereographic of Alaska",
+		"\n\tAzi(mod)",
+		NewAlsk,
+	)
+	core.RegisterConvertLPToXY("gs50",
+		"Mod. Stereographic of 50 U.S.",
+		"\n\tAzi(mod)",
+		NewGs50,
+	)
+}
+
+const modSterEpsln = 1e-12
+
+// Miller Oblated Stereographic
+var modSterMilOs = []complex128{
+	complex(0.924500, 0.),
+	complex(0., 0.),
+	complex(0.019430, 0.),
+}
+
+// Lee Oblated Stereographic
+var modSterLeeOs = []complex128{
+	complex(0.721316, 0.),
+	complex(0., 0.),
+	complex(-0.0088162, -0.00617325),
+}
+
+// 48 United States
+var modSterGs48 = []complex128{
+	complex(0.98879, 0.),
+	complex(0., 0.),
+	complex(-0.050909, 0.),
+	complex(0., 0.),
+	complex(0.075528, 0.),
+}
+
+// Alaska ellipsoid
+var modSterAlskE = []complex128{
+	complex(.9945303, 0.),
+	complex(.0052083, -.0027404),
+	complex(.0072721, .0048181),
+	complex(-.0151089, -.1932526),
+	complex(.0642675, -.1381226),
+	complex(.3582802, -.2884586),
+}
+
+// Alaska sphere
+var modSterAlskS = []complex128{
+	complex(.9972523, 0.),
+	complex(.0052513, -.0041175),
+	complex(.0074606, .0048125),
+	complex(-.0153783, -.1968253),
+	complex(.0636871, -.1408027),
+	complex(.3660976, -.2937382),
+}
+
+// GS50 ellipsoid
+var modSterGs50E = []complex128{
+	complex(.9827497, 0.),
+	complex(.0210669, .0053804),
+	complex(-.1031415, -.0571664),
+	complex(-.0323337, -.0322847),
+	complex(.0502303, .1211983),
+	complex(.0251805, .0895678),
+	complex(-.0012315, -.1416121),
+	complex(.0072202, -.1317091),
+	complex(-.0194029, .0759677),
+	complex(-.0210072, .0834037),
+}
+
+// GS50 sphere
+var modSterGs50S = []complex128{
+	complex(.9842990, 0.),
+	complex(.0211642, .0037608),
+	complex(-.1036018, -.0575102),
+	complex(-.0329095, -.0320119),
+	complex(.0499471, .1223335),
+	complex(.0260460, .0899805),
+	complex(.0007388, -.1435792),
+	complex(.0075848, -.1334108),
+	complex(-.0216473, .0776645),
+	complex(-.0225161, .0853673),
+}
+
+// ModSter implements core.IOperation and core.ConvertLPToXY
+//
+// These are the modified stereographic projections, where a complex
+// polynomial is applied to the oblique stereographic coordinates.
+type ModSter struct {
+	core.Operation
+	zcoeff []complex128
+	cchio  float64
+	schio  float64
+}
+
+// NewMilOs returns a new Miller Oblated Stereographic
+func NewMilOs(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ModSter{}
+	op.System = system
+
+	P := op.System
+	P.Lam0 = support.DDToR(20.)
+	P.Phi0 = support.DDToR(18.)
+	P.Ellipsoid.Es = 0.
+	P.Ellipsoid.E = 0.
+
+	return op.setup(modSterMilOs)
+}
+
+// NewLeeOs returns a new Lee Oblated Stereographic
+func NewLeeOs(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ModSter{}
+	op.System = system
+
+	P := op.System
+	P.Lam0 = support.DDToR(-165.)
+	P.Phi0 = support.DDToR(-10.)
+	P.Ellipsoid.Es = 0.
+	P.Ellipsoid.E = 0.
+
+	return op.setup(modSterLeeOs)
+}
+
+// NewGs48 returns a new Mod. Stereographic of 48 U.S.
+func NewGs48(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ModSter{}
+	op.System = system
+
+	P := op.System
+	PE := P.Ellipsoid
+	P.Lam0 = support.DDToR(-96.)
+	P.Phi0 = support.DDToR(39.)
+	PE.Es = 0.
+	PE.E = 0.
+	PE.A = 6370997.
+	PE.Ra = 1. / PE.A
+
+	return op.setup(modSterGs48)
+}
+
+// NewAlsk returns a new Mod. Stereographic of Alaska
+func NewAlsk(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ModSter{}
+	op.System = system
+
+	P := op.System
+	P.Lam0 = support.DDToR(-152.)
+	P.Phi0 = support.DDToR(64.)
+
+	// fixed ellipsoid/sphere
+	if op.setFixedEarth() {
+		return op.setup(modSterAlskE)
+	}
+	return op.setup(modSterAlskS)
+}
+
+// NewGs50 returns a new Mod. Stereographic of 50 U.S.
+func NewGs50(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ModSter{}
+	op.System = system
+
+	P := op.System
+	P.Lam0 = support.DDToR(-120.)
+	P.Phi0 = support.DDToR(45.)
+
+	// fixed ellipsoid/sphere
+	if op.setFixedEarth() {
+		return op.setup(modSterGs50E)
+	}
+	return op.setup(modSterGs50S)
+}
+
+// setFixedEarth replaces the ellipsoid with Clarke 1866, or the
+// sphere with one of radius 6370997m, and reports which one was used
+func (op *ModSter) setFixedEarth() bool {
+	PE := op.System.Ellipsoid
+
+	isEllipsoid := PE.Es != 0.0
+	if isEllipsoid {
+		PE.A = 6378206.4
+		PE.Es = 0.00676866
+		PE.E = math.Sqrt(PE.Es)
+		PE.OneEs = 1. - PE.Es
+		PE.ROneEs = 1. / PE.OneEs
+	} else {
+		PE.A = 6370997.
+	}
+	PE.Ra = 1. / PE.A
+
+	return isEllipsoid
+}
+
+func (op *ModSter) setup(zcoeff []complex128) (core.IConvertLPToXY, error) {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+
+	Q.zcoeff = zcoeff
+
+	var chio float64
+	if PE.Es != 0.0 {
+		esphi := PE.E * math.Sin(P.Phi0)
+		chio = 2.*math.Atan(math.Tan((support.PiOverTwo+P.Phi0)*.5)*
+			math.Pow((1.-esphi)/(1.+esphi), PE.E*.5)) - support.PiOverTwo
+	} else {
+		chio = P.Phi0
+	}
+	Q.schio = math.Sin(chio)
+	Q.cchio = math.Cos(chio)
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *ModSter) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	sinlon := math.Sin(lp.Lam)
+	coslon := math.Cos(lp.Lam)
+	esphi := PE.E * math.Sin(lp.Phi)
+	chi := 2.*math.Atan(math.Tan((support.PiOverTwo+lp.Phi)*.5)*
+		math.Pow((1.-esphi)/(1.+esphi), PE.E*.5)) - support.PiOverTwo
+	schi := math.Sin(chi)
+	cchi := math.Cos(chi)
+	s := 2. / (1. + Q.schio*schi + Q.cchio*cchi*coslon)
+
+	p := complex(s*cchi*sinlon, s*(Q.cchio*schi-Q.schio*cchi*coslon))
+	p = support.Zpoly1(p, Q.zcoeff)
+
+	return &core.CoordXY{X: real(p), Y: imag(p)}, nil
+}
+
+// Inverse goes backwards
+func (op *ModSter) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+
+	target := complex(xy.X, xy.Y)
+	p := target
+
+	converged := false
+	for nn := 20; nn > 0; nn-- {
+		fxy, fpxy := support.Zpolyd1(p, Q.zcoeff)
+		dp := -(fxy - target) / fpxy
+		p += dp
+		if math.Abs(real(dp))+math.Abs(imag(dp)) <= modSterEpsln {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	rh := math.Hypot(real(p), imag(p))
+	z := 2. * math.Atan(.5*rh)
+	sinz := math.Sin(z)
+	cosz := math.Cos(z)
+	if math.Abs(rh) <= modSterEpsln {
+		// input coordinates were (0,0)
+		return &core.CoordLP{Lam: 0.0, Phi: P.Phi0}, nil
+	}
+
+	chi := support.Aasin(cosz*Q.schio + imag(p)*sinz*Q.cchio/rh)
+	phi := chi
+
+	converged = false
+	for nn := 20; nn > 0; nn-- {
+		esphi := PE.E * math.Sin(phi)
+		dphi := 2.*math.Atan(math.Tan((support.PiOverTwo+chi)*.5)*
+			math.Pow((1.+esphi)/(1.-esphi), PE.E*.5)) - support.PiOverTwo - phi
+		phi += dphi
+		if math.Abs(dphi) <= modSterEpsln {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	lp := &core.CoordLP{
+		Phi: phi,
+		Lam: math.Atan2(real(p)*sinz, rh*Q.cchio*cosz-imag(p)*Q.schio*sinz),
+	}
+	return lp, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("moll",
+		"Mollweide",
+		"\n\tPCyl, Sph",
+		NewMoll,
+	)
+	core.RegisterConvertLPToXY("wag4",
+		"Wagner IV",
+		"\n\tPCyl, Sph",
+		NewWag4,
+	)
+	core.RegisterConvertLPToXY("wag5",
+		"Wagner V",
+		"\n\tPCyl, Sph",
+		NewWag5,
+	)
+}
+
+const mollMaxIter = 30
+const mollLoopTol = 1e-7
+
+// Moll implements core.IOperation and core.ConvertLPToXY
+type Moll struct {
+	core.Operation
+	Cx float64
+	Cy float64
+	Cp float64
+}
+
+// NewMoll returns a new Mollweide
+func NewMoll(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Moll{}
+	op.System = system
+
+	op.setup(support.PiOverTwo)
+	return op, nil
+}
+
+// NewWag4 returns a new Wagner IV
+func NewWag4(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Moll{}
+	op.System = system
+
+	op.setup(support.Pi / 3.)
+	return op, nil
+}
+
+// NewWag5 returns a new Wagner V
+func NewWag5(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Moll{
+		Cx: 0.90977,
+		Cy: 1.65014,
+		Cp: 3.00896,
+	}
+	op.System = system
+
+	op.System.Ellipsoid.Es = 0
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Moll) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	k := op.Cp * math.Sin(lp.Phi)
+	i := mollMaxIter
+	for ; i > 0; i-- {
+		V := (lp.Phi + math.Sin(lp.Phi) - k) / (1. + math.Cos(lp.Phi))
+		lp.Phi -= V
+		if math.Abs(V) < mollLoopTol {
+			break
+		}
+	}
+	if i == 0 {
+		if lp.Phi < 0. {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+	} else {
+		lp.Phi *= 0.5
+	}
+	xy.X = op.Cx * lp.Lam * math.Cos(lp.Phi)
+	xy.Y = op.Cy * math.Sin(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Moll) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = support.Aasin(xy.Y / op.Cy)
+	lp.Lam = xy.X / (op.Cx * math.Cos(lp.Phi))
+	if math.Abs(lp.Lam) >= support.Pi {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	lp.Phi += lp.Phi
+	lp.Phi = support.Aasin((lp.Phi + math.Sin(lp.Phi)) / op.Cp)
+	return lp, nil
+}
+
+func (op *Moll) setup(p float64) {
+	op.System.Ellipsoid.Es = 0
+
+	p2 := p + p
+	sp := math.Sin(p)
+	r := math.Sqrt(support.TwoPi * sp / (p2 + math.Sin(p2)))
+
+	op.Cx = 2. * r / support.Pi
+	op.Cy = r / sp
+	op.Cp = p2 + math.Sin(p2)
+}
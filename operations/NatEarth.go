@@ -0,0 +1,121 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("natearth",
+		"Natural Earth",
+		"\n\tPCyl, Sph",
+		NewNatEarth,
+	)
+}
+
+// The Natural Earth projection was designed by Tom Patterson, US National
+// Park Service, in 2007, using Flex Projector. The shape of the original
+// projection was defined at every 5 degrees and piece-wise cubic spline
+// interpolation was used to compute the complete graticule.
+// The code here uses polynomial functions instead of cubic splines and
+// is therefore much simpler to program. The polynomial approximation was
+// developed by Bojan Savric, in collaboration with Tom Patterson and Bernhard
+// Jenny, Institute of Cartography, ETH Zurich. It slightly deviates from
+// Patterson's original projection by adding additional curvature to meridians
+// where they meet the horizontal pole line. This improvement is by intention
+// and designed in collaboration with Tom Patterson.
+const (
+	natearthA0 = 0.8707
+	natearthA1 = -0.131979
+	natearthA2 = -0.013791
+	natearthA3 = 0.003971
+	natearthA4 = -0.001529
+	natearthB0 = 1.007226
+	natearthB1 = 0.015085
+	natearthB2 = -0.044475
+	natearthB3 = 0.028874
+	natearthB4 = -0.005916
+	natearthC0 = natearthB0
+	natearthC1 = (3 * natearthB1)
+	natearthC2 = (7 * natearthB2)
+	natearthC3 = (9 * natearthB3)
+	natearthC4 = (11 * natearthB4)
+
+	natearthEps     = 1e-11
+	natearthMaxY    = (0.8707 * 0.52 * math.Pi)
+	natearthMaxIter = 100
+)
+
+// NatEarth implements core.IOperation and core.ConvertLPToXY
+type NatEarth struct {
+	core.Operation
+}
+
+// NewNatEarth returns a new NatEarth
+func NewNatEarth(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &NatEarth{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *NatEarth) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	phi2 := lp.Phi * lp.Phi
+	phi4 := phi2 * phi2
+
+	xy.X = lp.Lam * (natearthA0 + phi2*(natearthA1+phi2*(natearthA2+phi4*phi2*(natearthA3+phi2*natearthA4))))
+	xy.Y = lp.Phi * (natearthB0 + phi2*(natearthB1+phi4*(natearthB2+natearthB3*phi2+natearthB4*phi4)))
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *NatEarth) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	/* make sure y is inside valid range */
+	if xy.Y > natearthMaxY {
+		xy.Y = natearthMaxY
+	} else if xy.Y < -natearthMaxY {
+		xy.Y = -natearthMaxY
+	}
+
+	/* latitude */
+	yc := xy.Y
+	i := natearthMaxIter
+	for ; i > 0; i-- { /* Newton-Raphson */
+		y2 := yc * yc
+		y4 := y2 * y2
+		f := (yc * (natearthB0 + y2*(natearthB1+y4*(natearthB2+natearthB3*y2+natearthB4*y4)))) - xy.Y
+		fder := natearthC0 + y2*(natearthC1+y4*(natearthC2+natearthC3*y2+natearthC4*y4))
+		tol := f / fder
+		yc -= tol
+		if math.Abs(tol) < natearthEps {
+			break
+		}
+	}
+	if i == 0 {
+		return nil, merror.New(merror.NonConvergent)
+	}
+	lp.Phi = yc
+
+	/* longitude */
+	y2 := yc * yc
+	lp.Lam = xy.X / (natearthA0 + y2*(natearthA1+y2*(natearthA2+y2*y2*y2*(natearthA3+y2*natearthA4))))
+
+	return lp, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("natearth2",
+		"Natural Earth 2",
+		"\n\tPCyl, Sph",
+		NewNatEarth2,
+	)
+}
+
+// The Natural Earth II projection was designed by Tom Patterson, and
+// developed with Bojan Savric and Bernhard Jenny as a polynomial
+// approximation, like Natural Earth.
+const (
+	natearth2A0 = 0.84719
+	natearth2A1 = -0.13063
+	natearth2A2 = -0.04515
+	natearth2A3 = 0.05494
+	natearth2A4 = -0.02326
+	natearth2A5 = 0.00331
+	natearth2B0 = 1.01183
+	natearth2B1 = -0.02625
+	natearth2B2 = 0.01926
+	natearth2B3 = -0.00396
+	natearth2C0 = natearth2B0
+	natearth2C1 = (9 * natearth2B1)
+	natearth2C2 = (11 * natearth2B2)
+	natearth2C3 = (13 * natearth2B3)
+
+	natearth2Eps     = 1e-11
+	natearth2MaxY    = (0.84719 * 0.535117535153096 * math.Pi)
+	natearth2MaxIter = 100
+)
+
+// NatEarth2 implements core.IOperation and core.ConvertLPToXY
+type NatEarth2 struct {
+	core.Operation
+}
+
+// NewNatEarth2 returns a new NatEarth2
+func NewNatEarth2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &NatEarth2{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *NatEarth2) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	phi2 := lp.Phi * lp.Phi
+	phi4 := phi2 * phi2
+	phi6 := phi2 * phi4
+
+	xy.X = lp.Lam * (natearth2A0 + natearth2A1*phi2 + phi6*phi6*(natearth2A2+natearth2A3*phi2+natearth2A4*phi4+natearth2A5*phi6))
+	xy.Y = lp.Phi * (natearth2B0 + phi4*phi4*(natearth2B1+natearth2B2*phi2+natearth2B3*phi4))
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *NatEarth2) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	/* make sure y is inside valid range */
+	if xy.Y > natearth2MaxY {
+		xy.Y = natearth2MaxY
+	} else if xy.Y < -natearth2MaxY {
+		xy.Y = -natearth2MaxY
+	}
+
+	/* latitude */
+	yc := xy.Y
+	i := natearth2MaxIter
+	for ; i > 0; i-- { /* Newton-Raphson */
+		y2 := yc * yc
+		y4 := y2 * y2
+		f := (yc * (natearth2B0 + y4*y4*(natearth2B1+natearth2B2*y2+natearth2B3*y4))) - xy.Y
+		fder := natearth2C0 + y4*y4*(natearth2C1+natearth2C2*y2+natearth2C3*y4)
+		tol := f / fder
+		yc -= tol
+		if math.Abs(tol) < natearth2Eps {
+			break
+		}
+	}
+	if i == 0 {
+		return nil, merror.New(merror.NonConvergent)
+	}
+	lp.Phi = yc
+
+	/* longitude */
+	y2 := yc * yc
+	y4 := y2 * y2
+	y6 := y2 * y4
+
+	lp.Lam = xy.X / (natearth2A0 + natearth2A1*y2 + y6*y6*(natearth2A2+natearth2A3*y2+natearth2A4*y4+natearth2A5*y6))
+	return lp, nil
+}
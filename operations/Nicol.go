@@ -0,0 +1,92 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("nicol",
+		"Nicolosi Globular",
+		"\n\tMisc Sph, no inv.",
+		NewNicol,
+	)
+}
+
+const nicolEps = 1e-10
+
+// Nicol implements core.IOperation and core.ConvertLPToXY
+type Nicol struct {
+	core.Operation
+}
+
+// NewNicol returns a new Nicol
+func NewNicol(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Nicol{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Nicol) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if math.Abs(lp.Lam) < nicolEps {
+		xy.X = 0
+		xy.Y = lp.Phi
+	} else if math.Abs(lp.Phi) < nicolEps {
+		xy.X = lp.Lam
+		xy.Y = 0.
+	} else if math.Abs(math.Abs(lp.Lam)-support.PiOverTwo) < nicolEps {
+		xy.X = lp.Lam * math.Cos(lp.Phi)
+		xy.Y = support.PiOverTwo * math.Sin(lp.Phi)
+	} else if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < nicolEps {
+		xy.X = 0
+		xy.Y = lp.Phi
+	} else {
+		tb := support.PiOverTwo/lp.Lam - lp.Lam/support.PiOverTwo
+		c := lp.Phi / support.PiOverTwo
+		sp := math.Sin(lp.Phi)
+		d := (1 - c*c) / (sp - c)
+		r2 := tb / d
+		r2 *= r2
+		m := (tb*sp/d - 0.5*tb) / (1. + r2)
+		n := (sp/r2 + 0.5*d) / (1. + 1./r2)
+
+		xy.X = math.Cos(lp.Phi)
+		xy.X = math.Sqrt(m*m + xy.X*xy.X/(1.+r2))
+		if lp.Lam < 0. {
+			xy.X = support.PiOverTwo * (m - xy.X)
+		} else {
+			xy.X = support.PiOverTwo * (m + xy.X)
+		}
+
+		xy.Y = math.Sqrt(n*n - (sp*sp/r2+d*sp-1.)/(1.+1./r2))
+		if lp.Phi < 0. {
+			xy.Y = support.PiOverTwo * (n + xy.Y)
+		} else {
+			xy.Y = support.PiOverTwo * (n - xy.Y)
+		}
+	}
+
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Nicol) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("nzmg",
+		"New Zealand Map Grid",
+		"\n\tfixed Earth",
+		NewNzmg,
+	)
+}
+
+const nzmgEpsln = 1e-10
+const nzmgSec5ToRad = 0.4848136811095359935899141023
+const nzmgRadToSec5 = 2.062648062470963551564733573
+
+var nzmgBf = []complex128{
+	complex(.7557853228, 0.0),
+	complex(.249204646, 0.003371507),
+	complex(-.001541739, 0.041058560),
+	complex(-.10162907, 0.01727609),
+	complex(-.26623489, -0.36249218),
+	complex(-.6870983, -1.1651967),
+}
+
+var nzmgTphi = []float64{1.5627014243, .5185406398, -.03333098,
+	-.1052906, -.0368594, .007317,
+	.01220, .00394, -.0013}
+
+var nzmgTpsi = []float64{.6399175073, -.1358797613, .063294409,
+	-.02526853, .0117879, -.0055161,
+	.0026906, -.001333, .00067, -.00034}
+
+// Nzmg implements core.IOperation and core.ConvertLPToXY
+type Nzmg struct {
+	core.Operation
+}
+
+// NewNzmg returns a new Nzmg
+func NewNzmg(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Nzmg{}
+	op.System = system
+
+	P := op.System
+	PE := P.Ellipsoid
+
+	// force to International major axis
+	PE.A = 6378388.0
+	PE.Ra = 1. / PE.A
+	P.Lam0 = support.DDToR(173.)
+	P.Phi0 = support.DDToR(-41.)
+	P.X0 = 2510000.
+	P.Y0 = 6023150.
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Nzmg) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	P := op.System
+
+	phi := (lp.Phi - P.Phi0) * nzmgRadToSec5
+
+	n := len(nzmgTpsi) - 1
+	r := nzmgTpsi[n]
+	for i := n - 1; i >= 0; i-- {
+		r = nzmgTpsi[i] + phi*r
+	}
+	r *= phi
+
+	p := support.Zpoly1(complex(r, lp.Lam), nzmgBf)
+
+	return &core.CoordXY{X: imag(p), Y: real(p)}, nil
+}
+
+// Inverse goes backwards
+func (op *Nzmg) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	P := op.System
+
+	target := complex(xy.Y, xy.X)
+	p := target
+
+	converged := false
+	for nn := 20; nn > 0; nn-- {
+		f, fp := support.Zpolyd1(p, nzmgBf)
+		dp := -(f - target) / fp
+		p += dp
+		if math.Abs(real(dp))+math.Abs(imag(dp)) <= nzmgEpsln {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	n := len(nzmgTphi) - 1
+	phi := nzmgTphi[n]
+	for i := n - 1; i >= 0; i-- {
+		phi = nzmgTphi[i] + real(p)*phi
+	}
+
+	lp := &core.CoordLP{
+		Lam: imag(p),
+		Phi: P.Phi0 + real(p)*phi*nzmgSec5ToRad,
+	}
+	return lp, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("ob_tran",
+		"General Oblique Transformation",
+		"\n\tMisc Sph"+
+			"\n\to_proj= plus parameters for projection"+
+			"\n\to_lat_p= o_lon_p= (new pole) or"+
+			"\n\to_alpha= o_lon_c= o_lat_c= or"+
+			"\n\to_lon_1= o_lat_1= o_lon_2= o_lat_2=",
+		NewObTran,
+	)
+}
+
+// ObTran implements core.IOperation and core.ConvertLPToXY
+//
+// The graticule is rotated to a new pole and the result is handed
+// to the inner ("o_proj") projection.
+type ObTran struct {
+	core.Operation
+	link      core.IConvertLPToXY
+	lamp      float64
+	cphip     float64
+	sphip     float64
+	isOblique bool
+}
+
+// NewObTran returns a new ObTran
+func NewObTran(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &ObTran{}
+	op.System = system
+
+	err := op.obTranSetup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *ObTran) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+
+	coslam := math.Cos(lp.Lam)
+	sinphi := math.Sin(lp.Phi)
+	cosphi := math.Cos(lp.Phi)
+
+	var lam, phi float64
+	if Q.isOblique {
+		lam = support.Adjlon(support.Aatan2(cosphi*math.Sin(lp.Lam),
+			Q.sphip*cosphi*coslam+Q.cphip*sinphi) + Q.lamp)
+		phi = support.Aasin(Q.sphip*sinphi - Q.cphip*cosphi*coslam)
+	} else {
+		lam = support.Adjlon(support.Aatan2(cosphi*math.Sin(lp.Lam), sinphi) + Q.lamp)
+		phi = support.Aasin(-cosphi * coslam)
+	}
+
+	return Q.link.Forward(&core.CoordLP{Lam: lam, Phi: phi})
+}
+
+// Inverse goes backwards
+func (op *ObTran) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+
+	lp, err := Q.link.Inverse(xy)
+	if err != nil {
+		return nil, err
+	}
+	if lp.Lam == math.MaxFloat64 {
+		return lp, nil
+	}
+
+	t := lp.Lam - Q.lamp
+	sinphi := math.Sin(lp.Phi)
+	cosphi := math.Cos(lp.Phi)
+	cost := math.Cos(t)
+
+	if Q.isOblique {
+		lp.Phi = support.Aasin(Q.sphip*sinphi + Q.cphip*cosphi*cost)
+		lp.Lam = support.Aatan2(cosphi*math.Sin(t), Q.sphip*cosphi*cost-Q.cphip*sinphi)
+	} else {
+		lp.Lam = support.Aatan2(cosphi*math.Sin(t), -sinphi)
+		lp.Phi = support.Aasin(cosphi * cost)
+	}
+
+	return lp, nil
+}
+
+// obTranTargetProjString builds the proj string of the inner projection:
+// everything from the outer string, except that "o_proj" takes the
+// place of "proj"
+func obTranTargetProjString(ps *support.ProjString) *support.ProjString {
+	target := &support.ProjString{
+		Pairs: []support.Pair{},
+	}
+
+	for _, pair := range ps.Pairs {
+		switch pair.Key {
+		case "proj":
+			continue
+		case "o_proj":
+			target.Add(support.Pair{Key: "proj", Value: pair.Value})
+		default:
+			target.Add(pair)
+		}
+	}
+
+	return target
+}
+
+func (op *ObTran) obTranSetup(sys *core.System) error {
+	Q := op
+	P := op.System
+	ps := sys.ProjString
+
+	name, ok := ps.GetAsString("o_proj")
+	if !ok || name == "" {
+		return merror.New(merror.NoRotationProj)
+	}
+	if name == "ob_tran" {
+		return merror.New(merror.InvalidArg, "o_proj=ob_tran")
+	}
+
+	// the inner projection is looked up in the operation table and
+	// set up from its own System, like any other
+	innerSys, innerOp, err := core.NewSystem(obTranTargetProjString(ps))
+	if err != nil {
+		return err
+	}
+	conv, ok := innerOp.(*core.ConvertLPToXY)
+	if !ok {
+		return merror.New(merror.NotYetSupported, name)
+	}
+
+	// we call the bare algorithm: the outer System does the
+	// scaling and offsets
+	Q.link = conv.Algorithm
+
+	var phip float64
+
+	if ps.ContainsKey("o_alpha") {
+		lamc, _ := ps.GetAsFloat("o_lon_c")
+		phic, _ := ps.GetAsFloat("o_lat_c")
+		alpha, _ := ps.GetAsFloat("o_alpha")
+		lamc = support.DDToR(lamc)
+		phic = support.DDToR(phic)
+		alpha = support.DDToR(alpha)
+
+		if math.Abs(math.Abs(phic)-support.PiOverTwo) <= tol10 {
+			return merror.New(merror.Lat0OrAlphaEq90)
+		}
+
+		Q.lamp = lamc + support.Aatan2(-math.Cos(alpha), -math.Sin(alpha)*math.Sin(phic))
+		phip = support.Aasin(math.Cos(phic) * math.Sin(alpha))
+
+	} else if ps.ContainsKey("o_lat_p") {
+		lamp, _ := ps.GetAsFloat("o_lon_p")
+		phip, _ = ps.GetAsFloat("o_lat_p")
+		Q.lamp = support.DDToR(lamp)
+		phip = support.DDToR(phip)
+
+	} else {
+		lam1, _ := ps.GetAsFloat("o_lon_1")
+		phi1, _ := ps.GetAsFloat("o_lat_1")
+		lam2, _ := ps.GetAsFloat("o_lon_2")
+		phi2, _ := ps.GetAsFloat("o_lat_2")
+		lam1 = support.DDToR(lam1)
+		phi1 = support.DDToR(phi1)
+		lam2 = support.DDToR(lam2)
+		phi2 = support.DDToR(phi2)
+
+		con := math.Abs(phi1)
+		if math.Abs(phi1-phi2) <= tol10 ||
+			con <= tol10 ||
+			math.Abs(con-support.PiOverTwo) <= tol10 ||
+			math.Abs(math.Abs(phi2)-support.PiOverTwo) <= tol10 {
+			return merror.New(merror.Lat1OrLat2ZeroOr90)
+		}
+
+		Q.lamp = math.Atan2(math.Cos(phi1)*math.Sin(phi2)*math.Cos(lam1)-
+			math.Sin(phi1)*math.Cos(phi2)*math.Cos(lam2),
+			math.Sin(phi1)*math.Cos(phi2)*math.Sin(lam2)-
+				math.Cos(phi1)*math.Sin(phi2)*math.Sin(lam1))
+		phip = math.Atan(-math.Cos(Q.lamp-lam1) / math.Tan(phi1))
+	}
+
+	if math.Abs(phip) > tol10 {
+		// oblique
+		Q.cphip = math.Cos(phip)
+		Q.sphip = math.Sin(phip)
+		Q.isOblique = true
+	} else {
+		// transverse
+		Q.isOblique = false
+	}
+
+	// if the rotated projection is actually latlong, we don't
+	// want any scaling
+	if innerSys.Right == core.IOUnitsAngular {
+		P.Right = core.IOUnitsWhatever
+	}
+
+	return nil
+}
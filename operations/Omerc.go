@@ -0,0 +1,279 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("omerc",
+		"Oblique Mercator",
+		"\n\tCyl, Sph&Ell no_rot\n\talpha= [gamma=] [no_off] lonc= or\n\tlon_1= lat_1= lon_2= lat_2=",
+		NewOmerc,
+	)
+}
+
+// Omerc implements core.IOperation and core.ConvertLPToXY
+type Omerc struct {
+	core.Operation
+
+	// the "opaque" parts
+
+	A      float64
+	B      float64
+	E      float64
+	AB     float64
+	ArB    float64
+	BrA    float64
+	rB     float64
+	singam float64
+	cosgam float64
+	sinrot float64
+	cosrot float64
+	vPoleN float64
+	vPoleS float64
+	u0     float64
+	noRot  bool
+}
+
+// NewOmerc returns a new Omerc
+func NewOmerc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Omerc{}
+	op.System = system
+
+	err := op.setup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Omerc) Forward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	var u, v float64
+
+	if math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) > eps10 {
+		W := Q.E / math.Pow(support.Tsfn(lp.Phi, math.Sin(lp.Phi), PE.E), Q.B)
+		temp := 1. / W
+		S := .5 * (W - temp)
+		T := .5 * (W + temp)
+		V := math.Sin(Q.B * lp.Lam)
+		U := (S*Q.singam - V*Q.cosgam) / T
+		if math.Abs(math.Abs(U)-1.0) < eps10 {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+		v = 0.5 * Q.ArB * math.Log((1.-U)/(1.+U))
+		temp = math.Cos(Q.B * lp.Lam)
+		if math.Abs(temp) < tol7 {
+			u = Q.A * lp.Lam
+		} else {
+			u = Q.ArB * math.Atan2((S*Q.cosgam+V*Q.singam), temp)
+		}
+	} else {
+		if lp.Phi > 0 {
+			v = Q.vPoleN
+		} else {
+			v = Q.vPoleS
+		}
+		u = Q.ArB * lp.Phi
+	}
+	if Q.noRot {
+		xy.X = u
+		xy.Y = v
+	} else {
+		u -= Q.u0
+		xy.X = v*Q.cosrot + u*Q.sinrot
+		xy.Y = u*Q.cosrot - v*Q.sinrot
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Omerc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	var u, v float64
+
+	if Q.noRot {
+		v = xy.Y
+		u = xy.X
+	} else {
+		v = xy.X*Q.cosrot - xy.Y*Q.sinrot
+		u = xy.Y*Q.cosrot + xy.X*Q.sinrot + Q.u0
+	}
+	Qp := math.Exp(-Q.BrA * v)
+	if Qp == 0 {
+		return nil, merror.New(merror.InvalidXOrY)
+	}
+	Sp := .5 * (Qp - 1./Qp)
+	Tp := .5 * (Qp + 1./Qp)
+	Vp := math.Sin(Q.BrA * u)
+	Up := (Vp*Q.cosgam + Sp*Q.singam) / Tp
+	if math.Abs(math.Abs(Up)-1.) < eps10 {
+		lp.Lam = 0.
+		if Up < 0. {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+	} else {
+		lp.Phi = Q.E / math.Sqrt((1.+Up)/(1.-Up))
+		var err error
+		lp.Phi, err = support.Phi2(math.Pow(lp.Phi, 1./Q.B), PE.E)
+		if err != nil {
+			return nil, err
+		}
+		lp.Lam = -Q.rB * math.Atan2((Sp*Q.cosgam-Vp*Q.singam), math.Cos(Q.BrA*u))
+	}
+	return lp, nil
+}
+
+func (op *Omerc) setup(sys *core.System) error {
+	var con, cosph0, D, F, H, L, sinph0, p, J, gamma0 float64
+	var gamma, lamc, lam1, lam2, phi1, phi2, alphaC float64
+	var noOff bool
+
+	Q := op
+	P := op.System
+	PE := op.System.Ellipsoid
+	ps := P.ProjString
+
+	getRadians := func(key string) float64 {
+		f, _ := ps.GetAsFloat(key)
+		return support.DDToR(f)
+	}
+
+	Q.noRot = ps.ContainsKey("no_rot")
+	alp := ps.ContainsKey("alpha")
+	if alp {
+		alphaC = getRadians("alpha")
+	}
+	gam := ps.ContainsKey("gamma")
+	if gam {
+		gamma = getRadians("gamma")
+	}
+	if alp || gam {
+		lamc = getRadians("lonc")
+		noOff = ps.ContainsKey("no_off") || /* for libproj4 compatibility */
+			ps.ContainsKey("no_uoff") /* for backward compatibility */
+	} else {
+		lam1 = getRadians("lon_1")
+		phi1 = getRadians("lat_1")
+		lam2 = getRadians("lon_2")
+		phi2 = getRadians("lat_2")
+		con = math.Abs(phi1)
+		if math.Abs(phi1-phi2) <= tol7 ||
+			con <= tol7 ||
+			math.Abs(con-support.PiOverTwo) <= tol7 ||
+			math.Abs(math.Abs(P.Phi0)-support.PiOverTwo) <= tol7 ||
+			math.Abs(math.Abs(phi2)-support.PiOverTwo) <= tol7 {
+			return merror.New(merror.Lat0OrAlphaEq90)
+		}
+	}
+	com := math.Sqrt(PE.OneEs)
+	if math.Abs(P.Phi0) > eps10 {
+		sinph0 = math.Sin(P.Phi0)
+		cosph0 = math.Cos(P.Phi0)
+		con = 1. - PE.Es*sinph0*sinph0
+		Q.B = cosph0 * cosph0
+		Q.B = math.Sqrt(1. + PE.Es*Q.B*Q.B/PE.OneEs)
+		Q.A = Q.B * P.K0 * com / con
+		D = Q.B * com / (cosph0 * math.Sqrt(con))
+		F = D*D - 1.
+		if F <= 0. {
+			F = 0.
+		} else {
+			F = math.Sqrt(F)
+			if P.Phi0 < 0. {
+				F = -F
+			}
+		}
+		F += D
+		Q.E = F
+		Q.E *= math.Pow(support.Tsfn(P.Phi0, sinph0, PE.E), Q.B)
+	} else {
+		Q.B = 1. / com
+		Q.A = P.K0
+		D = 1.
+		F = 1.
+		Q.E = 1.
+	}
+	if alp || gam {
+		if alp {
+			gamma0 = support.Aasin(math.Sin(alphaC) / D)
+			if !gam {
+				gamma = alphaC
+			}
+		} else {
+			gamma0 = gamma
+			alphaC = support.Aasin(D * math.Sin(gamma0))
+		}
+		if math.Abs(math.Abs(P.Phi0)-support.PiOverTwo) <= tol7 {
+			return merror.New(merror.Lat0OrAlphaEq90)
+		}
+		P.Lam0 = lamc - support.Aasin(.5*(F-1./F)*math.Tan(gamma0))/Q.B
+	} else {
+		H = math.Pow(support.Tsfn(phi1, math.Sin(phi1), PE.E), Q.B)
+		L = math.Pow(support.Tsfn(phi2, math.Sin(phi2), PE.E), Q.B)
+		F = Q.E / H
+		p = (L - H) / (L + H)
+		if p == 0 {
+			return merror.New(merror.ToleranceCondition)
+		}
+		J = Q.E * Q.E
+		J = (J - L*H) / (J + L*H)
+		con = lam1 - lam2
+		if con < -support.Pi {
+			lam2 -= support.TwoPi
+		} else if con > support.Pi {
+			lam2 += support.TwoPi
+		}
+		P.Lam0 = support.Adjlon(.5*(lam1+lam2) - math.Atan(J*math.Tan(.5*Q.B*(lam1-lam2))/p)/Q.B)
+		con = F - 1./F
+		if con == 0 {
+			return merror.New(merror.ToleranceCondition)
+		}
+		gamma0 = math.Atan(2. * math.Sin(Q.B*support.Adjlon(lam1-P.Lam0)) / con)
+		alphaC = support.Aasin(D * math.Sin(gamma0))
+		gamma = alphaC
+	}
+	Q.singam = math.Sin(gamma0)
+	Q.cosgam = math.Cos(gamma0)
+	Q.sinrot = math.Sin(gamma)
+	Q.cosrot = math.Cos(gamma)
+	Q.rB = 1. / Q.B
+	Q.ArB = Q.A * Q.rB
+	Q.BrA = 1. / Q.ArB
+	Q.AB = Q.A * Q.B
+	if noOff {
+		Q.u0 = 0
+	} else {
+		Q.u0 = math.Abs(Q.ArB * math.Atan(math.Sqrt(D*D-1.)/math.Cos(alphaC)))
+		if P.Phi0 < 0. {
+			Q.u0 = -Q.u0
+		}
+	}
+	F = 0.5 * gamma0
+	Q.vPoleN = Q.ArB * math.Log(math.Tan(support.PiOverFour-F))
+	Q.vPoleS = Q.ArB * math.Log(math.Tan(support.PiOverFour+F))
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("patterson",
+		"Patterson Cylindrical",
+		"\n\tCyl, Sph",
+		NewPatterson,
+	)
+}
+
+const (
+	pattersonK1 = 1.0148
+	pattersonK2 = 0.23185
+	pattersonK3 = -0.14499
+	pattersonK4 = 0.02406
+	pattersonC1 = pattersonK1
+	pattersonC2 = 5.0 * pattersonK2
+	pattersonC3 = 7.0 * pattersonK3
+	pattersonC4 = 9.0 * pattersonK4
+
+	pattersonEps11   = 1.0e-11
+	pattersonMaxY    = 1.790857183
+	pattersonMaxIter = 100
+)
+
+// Patterson implements core.IOperation and core.ConvertLPToXY
+type Patterson struct {
+	core.Operation
+}
+
+// NewPatterson returns a new Patterson
+func NewPatterson(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Patterson{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Patterson) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	phi2 := lp.Phi * lp.Phi
+
+	xy := &core.CoordXY{}
+	xy.X = lp.Lam
+	xy.Y = lp.Phi * (pattersonK1 + phi2*phi2*(pattersonK2+phi2*(pattersonK3+pattersonK4*phi2)))
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Patterson) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	y := xy.Y
+
+	// make sure y is inside valid range
+	if y > pattersonMaxY {
+		y = pattersonMaxY
+	} else if y < -pattersonMaxY {
+		y = -pattersonMaxY
+	}
+
+	// Newton-Raphson
+	yc := y
+	converged := false
+	for i := pattersonMaxIter; i > 0; i-- {
+		y2 := yc * yc
+		f := (yc * (pattersonK1 + y2*y2*(pattersonK2+y2*(pattersonK3+pattersonK4*y2)))) - y
+		fder := pattersonC1 + y2*y2*(pattersonC2+y2*(pattersonC3+pattersonC4*y2))
+		tol := f / fder
+		yc -= tol
+		if math.Abs(tol) < pattersonEps11 {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	lp := &core.CoordLP{}
+	lp.Phi = yc
+	lp.Lam = xy.X
+
+	return lp, nil
+}
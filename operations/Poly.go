@@ -0,0 +1,211 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("poly",
+		"Polyconic (American)",
+		"\n\tConic, Sph&Ell",
+		NewPoly,
+	)
+}
+
+const polyTol = 1e-10
+const polyConv = 1e-10
+const polyNIter = 10
+const polyIIter = 20
+const polyITol = 1.e-12
+
+// Poly implements core.IOperation and core.ConvertLPToXY
+type Poly struct {
+	core.Operation
+	isSphere bool
+	ml0      float64
+	en       []float64
+}
+
+// NewPoly returns a new Poly
+func NewPoly(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Poly{}
+	op.System = system
+
+	op.setup(system)
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Poly) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+
+	if op.isSphere {
+		return op.sphericalForward(lp)
+	}
+	return op.ellipsoidalForward(lp)
+}
+
+// Inverse goes backwards
+func (op *Poly) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+
+	if op.isSphere {
+		return op.sphericalInverse(xy)
+	}
+	return op.ellipsoidalInverse(xy)
+}
+
+//---------------------------------------------------------------------
+
+func (op *Poly) ellipsoidalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Ellipsoidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	if math.Abs(lp.Phi) <= polyTol {
+		xy.X = lp.Lam
+		xy.Y = -op.ml0
+	} else {
+		sp, cp := math.Sincos(lp.Phi)
+		ms := 0.
+		if math.Abs(cp) > polyTol {
+			ms = support.Msfn(sp, cp, PE.Es) / sp
+		}
+		lp.Lam *= sp
+		xy.X = ms * math.Sin(lp.Lam)
+		xy.Y = (support.Mlfn(lp.Phi, sp, cp, op.en) - op.ml0) + ms*(1.-math.Cos(lp.Lam))
+	}
+	return xy, nil
+}
+
+func (op *Poly) sphericalForward(lp *core.CoordLP) (*core.CoordXY, error) { /* Spheroidal, forward */
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	P := op.System
+
+	if math.Abs(lp.Phi) <= polyTol {
+		xy.X = lp.Lam
+		xy.Y = op.ml0
+	} else {
+		cot := 1. / math.Tan(lp.Phi)
+		E := lp.Lam * math.Sin(lp.Phi)
+		xy.X = math.Sin(E) * cot
+		xy.Y = lp.Phi - P.Phi0 + cot*(1.-math.Cos(E))
+	}
+	return xy, nil
+}
+
+func (op *Poly) ellipsoidalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Ellipsoidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	PE := op.System.Ellipsoid
+
+	y := xy.Y + op.ml0
+	if math.Abs(y) <= polyTol {
+		lp.Lam = xy.X
+		lp.Phi = 0.
+		return lp, nil
+	}
+
+	r := y*y + xy.X*xy.X
+	lp.Phi = y
+	i := polyIIter
+	for ; i > 0; i-- {
+		sp, cp := math.Sincos(lp.Phi)
+		s2ph := sp * cp
+		if math.Abs(cp) < polyITol {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+		mlp := math.Sqrt(1. - PE.Es*sp*sp)
+		c := sp * mlp / cp
+		ml := support.Mlfn(lp.Phi, sp, cp, op.en)
+		mlb := ml*ml + r
+		mlp = PE.OneEs / (mlp * mlp * mlp)
+		dPhi := (ml + ml + c*mlb - 2.*y*(c*ml+1.)) / (PE.Es*s2ph*(mlb-2.*y*ml)/c +
+			2.*(y-ml)*(c*mlp-1./s2ph) - mlp - mlp)
+		lp.Phi += dPhi
+		if math.Abs(dPhi) <= polyITol {
+			break
+		}
+	}
+	if i == 0 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+
+	/* the iteration can settle on a spurious root for points off the map */
+	if math.Abs(lp.Phi) > support.PiOverTwo {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	c := math.Sin(lp.Phi)
+	s := xy.X * math.Tan(lp.Phi) * math.Sqrt(1.-PE.Es*c*c)
+	if math.Abs(s) > 1.+eps10 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	lp.Lam = support.Aasin(s) / c
+
+	return lp, nil
+}
+
+func (op *Poly) sphericalInverse(xy *core.CoordXY) (*core.CoordLP, error) { /* Spheroidal, inverse */
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	P := op.System
+
+	y := P.Phi0 + xy.Y
+	if math.Abs(y) <= polyTol {
+		lp.Lam = xy.X
+		lp.Phi = 0.
+		return lp, nil
+	}
+
+	lp.Phi = y
+	B := xy.X*xy.X + y*y
+	i := polyNIter
+	for {
+		tp := math.Tan(lp.Phi)
+		dphi := (y*(lp.Phi*tp+1.) - lp.Phi - .5*(lp.Phi*lp.Phi+B)*tp) / ((lp.Phi-y)/tp - 1.)
+		lp.Phi -= dphi
+		if !(math.Abs(dphi) > polyConv) {
+			break
+		}
+		i--
+		if i == 0 {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+	}
+
+	/* the iteration can settle on a spurious root for points off the map */
+	if math.Abs(lp.Phi) > support.PiOverTwo {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	s := xy.X * math.Tan(lp.Phi)
+	if math.Abs(s) > 1.+eps10 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	lp.Lam = support.Aasin(s) / math.Sin(lp.Phi)
+
+	return lp, nil
+}
+
+func (op *Poly) setup(sys *core.System) {
+	P := op.System
+	PE := op.System.Ellipsoid
+
+	if PE.Es != 0.0 {
+		op.isSphere = false
+		op.en = support.Enfn(PE.Es)
+		op.ml0 = support.Mlfn(P.Phi0, math.Sin(P.Phi0), math.Cos(P.Phi0), op.en)
+	} else {
+		op.isSphere = true
+		op.ml0 = -P.Phi0
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("putp2",
+		"Putnins P2",
+		"\n\tPCyl, Sph",
+		NewPutp2,
+	)
+}
+
+const putp2Cx = 1.89490
+const putp2Cy = 1.71848
+const putp2Cp = 0.6141848493043784
+const putp2Eps = 1e-10
+const putp2NIter = 10
+const putp2PiDiv3 = 1.0471975511965977
+
+// Putp2 implements core.IOperation and core.ConvertLPToXY
+type Putp2 struct {
+	core.Operation
+}
+
+// NewPutp2 returns a new Putp2
+func NewPutp2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp2{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Putp2) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	p := putp2Cp * math.Sin(lp.Phi)
+	s := lp.Phi * lp.Phi
+	lp.Phi *= 0.615709 + s*(0.00909953+s*0.0046292)
+
+	i := putp2NIter
+	for ; i > 0; i-- {
+		c := math.Cos(lp.Phi)
+		s = math.Sin(lp.Phi)
+		V := (lp.Phi + s*(c-1.) - p) / (1. + c*(c-1.) - s*s)
+		lp.Phi -= V
+		if math.Abs(V) < putp2Eps {
+			break
+		}
+	}
+	if i == 0 {
+		if lp.Phi < 0 {
+			lp.Phi = -putp2PiDiv3
+		} else {
+			lp.Phi = putp2PiDiv3
+		}
+	}
+
+	xy.X = putp2Cx * lp.Lam * (math.Cos(lp.Phi) - 0.5)
+	xy.Y = putp2Cy * math.Sin(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Putp2) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = support.Aasin(xy.Y / putp2Cy)
+	c := math.Cos(lp.Phi)
+	lp.Lam = xy.X / (putp2Cx * (c - 0.5))
+	lp.Phi = support.Aasin((lp.Phi + math.Sin(lp.Phi)*(c-1.)) / putp2Cp)
+	return lp, nil
+}
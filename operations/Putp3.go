@@ -0,0 +1,79 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("putp3",
+		"Putnins P3",
+		"\n\tPCyl, Sph",
+		NewPutp3,
+	)
+	core.RegisterConvertLPToXY("putp3p",
+		"Putnins P3'",
+		"\n\tPCyl, Sph",
+		NewPutp3p,
+	)
+}
+
+const putp3C = 0.79788456
+const putp3RPiSq = 0.1013211836
+
+// Putp3 implements core.IOperation and core.ConvertLPToXY
+type Putp3 struct {
+	core.Operation
+	A float64
+}
+
+// NewPutp3 returns a new Putnins P3
+func NewPutp3(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp3{
+		A: 4. * putp3RPiSq,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewPutp3p returns a new Putnins P3'
+func NewPutp3p(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp3{
+		A: 2. * putp3RPiSq,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Putp3) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = putp3C * lp.Lam * (1. - op.A*lp.Phi*lp.Phi)
+	xy.Y = putp3C * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Putp3) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y / putp3C
+	lp.Lam = xy.X / (putp3C * (1. - op.A*lp.Phi*lp.Phi))
+	return lp, nil
+}
+
+func (op *Putp3) setup() {
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+}
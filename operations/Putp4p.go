@@ -0,0 +1,88 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("putp4p",
+		"Putnins P4'",
+		"\n\tPCyl, Sph",
+		NewPutp4p,
+	)
+	core.RegisterConvertLPToXY("weren",
+		"Werenskiold I",
+		"\n\tPCyl, Sph",
+		NewWeren,
+	)
+}
+
+// Putp4p implements core.IOperation and core.ConvertLPToXY
+type Putp4p struct {
+	core.Operation
+	Cx float64
+	Cy float64
+}
+
+// NewPutp4p returns a new Putnins P4'
+func NewPutp4p(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp4p{
+		Cx: 0.874038744,
+		Cy: 3.883251825,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewWeren returns a new Werenskiold I
+func NewWeren(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp4p{
+		Cx: 1.,
+		Cy: 4.442882938,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Putp4p) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lp.Phi = support.Aasin(0.883883476 * math.Sin(lp.Phi))
+	xy.X = op.Cx * lp.Lam * math.Cos(lp.Phi)
+	lp.Phi *= 0.333333333333333
+	xy.X /= math.Cos(lp.Phi)
+	xy.Y = op.Cy * math.Sin(lp.Phi)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Putp4p) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = support.Aasin(xy.Y / op.Cy)
+	lp.Lam = xy.X * math.Cos(lp.Phi) / op.Cx
+	lp.Phi *= 3.
+	lp.Lam /= math.Cos(lp.Phi)
+	lp.Phi = support.Aasin(1.13137085 * math.Sin(lp.Phi))
+	return lp, nil
+}
+
+func (op *Putp4p) setup() {
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+}
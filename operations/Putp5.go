@@ -0,0 +1,84 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("putp5",
+		"Putnins P5",
+		"\n\tPCyl, Sph",
+		NewPutp5,
+	)
+	core.RegisterConvertLPToXY("putp5p",
+		"Putnins P5'",
+		"\n\tPCyl, Sph",
+		NewPutp5p,
+	)
+}
+
+const putp5C = 1.01346
+const putp5D = 1.2158542
+
+// Putp5 implements core.IOperation and core.ConvertLPToXY
+type Putp5 struct {
+	core.Operation
+	A float64
+	B float64
+}
+
+// NewPutp5 returns a new Putnins P5
+func NewPutp5(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp5{
+		A: 2.,
+		B: 1.,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewPutp5p returns a new Putnins P5'
+func NewPutp5p(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp5{
+		A: 1.5,
+		B: 0.5,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Putp5) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = putp5C * lp.Lam * (op.A - op.B*math.Sqrt(1.+putp5D*lp.Phi*lp.Phi))
+	xy.Y = putp5C * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Putp5) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y / putp5C
+	lp.Lam = xy.X / (putp5C * (op.A - op.B*math.Sqrt(1.+putp5D*lp.Phi*lp.Phi)))
+	return lp, nil
+}
+
+func (op *Putp5) setup() {
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("putp6",
+		"Putnins P6",
+		"\n\tPCyl, Sph",
+		NewPutp6,
+	)
+	core.RegisterConvertLPToXY("putp6p",
+		"Putnins P6'",
+		"\n\tPCyl, Sph",
+		NewPutp6p,
+	)
+}
+
+const putp6Eps = 1e-10
+const putp6NIter = 10
+const putp6ConPole = 1.732050807568877
+
+// Putp6 implements core.IOperation and core.ConvertLPToXY
+type Putp6 struct {
+	core.Operation
+	Cx float64
+	Cy float64
+	A  float64
+	B  float64
+	D  float64
+}
+
+// NewPutp6 returns a new Putnins P6
+func NewPutp6(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp6{
+		Cx: 1.01346,
+		Cy: 0.91910,
+		A:  4.,
+		B:  2.1471437182129378784,
+		D:  2.,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// NewPutp6p returns a new Putnins P6'
+func NewPutp6p(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Putp6{
+		Cx: 0.44329,
+		Cy: 0.80404,
+		A:  6.,
+		B:  5.61125,
+		D:  3.,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Putp6) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	p := op.B * math.Sin(lp.Phi)
+	lp.Phi *= 1.10265779
+
+	i := putp6NIter
+	for ; i > 0; i-- {
+		r := math.Sqrt(1. + lp.Phi*lp.Phi)
+		V := ((op.A-r)*lp.Phi - math.Log(lp.Phi+r) - p) / (op.A - 2.*r)
+		lp.Phi -= V
+		if math.Abs(V) < putp6Eps {
+			break
+		}
+	}
+	if i == 0 {
+		if p < 0. {
+			lp.Phi = -putp6ConPole
+		} else {
+			lp.Phi = putp6ConPole
+		}
+	}
+
+	xy.X = op.Cx * lp.Lam * (op.D - math.Sqrt(1.+lp.Phi*lp.Phi))
+	xy.Y = op.Cy * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Putp6) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y / op.Cy
+	r := math.Sqrt(1. + lp.Phi*lp.Phi)
+	lp.Lam = xy.X / (op.Cx * (op.D - r))
+	lp.Phi = support.Aasin(((op.A-r)*lp.Phi - math.Log(lp.Phi+r)) / op.B)
+	return lp, nil
+}
+
+func (op *Putp6) setup() {
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+}
@@ -0,0 +1,460 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("qsc",
+		"Quadrilateralized Spherical Cube",
+		"\n\tAzi, Sph",
+		NewQsc,
+	)
+}
+
+// QscFace identifies one of the six faces of the cube
+type QscFace int
+
+// The cube faces. The front face is centered on lon=0, lat=0; the right,
+// back and left faces follow it eastwards around the equator.
+const (
+	QscFaceFront QscFace = iota
+	QscFaceRight
+	QscFaceBack
+	QscFaceLeft
+	QscFaceTop
+	QscFaceBottom
+)
+
+func (f QscFace) String() string {
+	switch f {
+	case QscFaceFront:
+		return "front"
+	case QscFaceRight:
+		return "right"
+	case QscFaceBack:
+		return "back"
+	case QscFaceLeft:
+		return "left"
+	case QscFaceTop:
+		return "top"
+	case QscFaceBottom:
+		return "bottom"
+	}
+	return "unknown"
+}
+
+/* The four areas on a cube face. AREA_0 is the area of definition,
+ * the other three areas are counted counterclockwise. */
+type qscArea int
+
+const (
+	qscArea0 qscArea = iota
+	qscArea1
+	qscArea2
+	qscArea3
+)
+
+// Qsc implements core.IOperation and core.ConvertLPToXY
+type Qsc struct {
+	core.Operation
+	face             QscFace
+	aSquared         float64
+	b                float64
+	oneMinusF        float64
+	oneMinusFSquared float64
+}
+
+// NewQsc returns a new Qsc
+func NewQsc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Qsc{}
+	op.System = system
+
+	op.setup(system)
+	return op, nil
+}
+
+// Face returns the cube face this projection is centered on, as
+// selected by lat_0 and lon_0
+func (op *Qsc) Face() QscFace {
+	return op.face
+}
+
+// FaceOf returns the cube face the point lp falls on. Unlike Forward,
+// the longitude is relative to Greenwich and not to lon_0, so one
+// can use FaceOf to pick which of six face-centered projections to
+// send a point to.
+func (op *Qsc) FaceOf(lp *core.CoordLP) QscFace {
+	lat := op.geocentricLatitude(lp.Phi)
+
+	x := math.Cos(lat) * math.Cos(lp.Lam)
+	y := math.Cos(lat) * math.Sin(lp.Lam)
+	z := math.Sin(lat)
+
+	ax, ay, az := math.Abs(x), math.Abs(y), math.Abs(z)
+	switch {
+	case az >= ax && az >= ay:
+		if z >= 0 {
+			return QscFaceTop
+		}
+		return QscFaceBottom
+	case ax >= ay:
+		if x >= 0 {
+			return QscFaceFront
+		}
+		return QscFaceBack
+	default:
+		if y >= 0 {
+			return QscFaceRight
+		}
+		return QscFaceLeft
+	}
+}
+
+//---------------------------------------------------------------------
+
+/* Helper function for forward projection: compute the theta angle
+ * and determine the area number. */
+func qscFwdEquatFaceTheta(phi, y, x float64) (float64, qscArea) {
+	var theta float64
+	var area qscArea
+
+	if phi < eps10 {
+		area = qscArea0
+		theta = 0.0
+	} else {
+		theta = math.Atan2(y, x)
+		if math.Abs(theta) <= support.PiOverFour {
+			area = qscArea0
+		} else if theta > support.PiOverFour && theta <= support.PiOverTwo+support.PiOverFour {
+			area = qscArea1
+			theta -= support.PiOverTwo
+		} else if theta > support.PiOverTwo+support.PiOverFour || theta <= -(support.PiOverTwo+support.PiOverFour) {
+			area = qscArea2
+			if theta >= 0.0 {
+				theta -= support.Pi
+			} else {
+				theta += support.Pi
+			}
+		} else {
+			area = qscArea3
+			theta += support.PiOverTwo
+		}
+	}
+	return theta, area
+}
+
+/* Helper function: shift the longitude. */
+func qscShiftLonOrigin(lon, offset float64) float64 {
+	slon := lon + offset
+	if slon < -support.Pi {
+		slon += support.TwoPi
+	} else if slon > +support.Pi {
+		slon -= support.TwoPi
+	}
+	return slon
+}
+
+/* Convert the geodetic latitude to a geocentric latitude.
+ * This corresponds to the shift from the ellipsoid to the sphere
+ * described in [LK12]. */
+func (op *Qsc) geocentricLatitude(phi float64) float64 {
+	if op.System.Ellipsoid.Es != 0.0 {
+		return math.Atan(op.oneMinusFSquared * math.Tan(phi))
+	}
+	return phi
+}
+
+// Forward goes forewards
+func (op *Qsc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+
+	var theta, phi float64
+	var area qscArea
+
+	lat := op.geocentricLatitude(lp.Phi)
+
+	/* Convert the input lat, lon into theta, phi as used by QSC.
+	 * This depends on the cube face and the area on it.
+	 * For the top and bottom face, we can compute theta and phi
+	 * directly from phi, lam. For the other faces, we must use
+	 * unit sphere cartesian coordinates as an intermediate step. */
+	lon := lp.Lam
+	if Q.face == QscFaceTop {
+		phi = support.PiOverTwo - lat
+		if lon >= support.PiOverFour && lon <= support.PiOverTwo+support.PiOverFour {
+			area = qscArea0
+			theta = lon - support.PiOverTwo
+		} else if lon > support.PiOverTwo+support.PiOverFour || lon <= -(support.PiOverTwo+support.PiOverFour) {
+			area = qscArea1
+			if lon > 0.0 {
+				theta = lon - support.Pi
+			} else {
+				theta = lon + support.Pi
+			}
+		} else if lon > -(support.PiOverTwo+support.PiOverFour) && lon <= -support.PiOverFour {
+			area = qscArea2
+			theta = lon + support.PiOverTwo
+		} else {
+			area = qscArea3
+			theta = lon
+		}
+	} else if Q.face == QscFaceBottom {
+		phi = support.PiOverTwo + lat
+		if lon >= support.PiOverFour && lon <= support.PiOverTwo+support.PiOverFour {
+			area = qscArea0
+			theta = -lon + support.PiOverTwo
+		} else if lon < support.PiOverFour && lon >= -support.PiOverFour {
+			area = qscArea1
+			theta = -lon
+		} else if lon < -support.PiOverFour && lon >= -(support.PiOverTwo+support.PiOverFour) {
+			area = qscArea2
+			theta = -lon - support.PiOverTwo
+		} else {
+			area = qscArea3
+			if lon > 0.0 {
+				theta = -lon + support.Pi
+			} else {
+				theta = -lon - support.Pi
+			}
+		}
+	} else {
+		if Q.face == QscFaceRight {
+			lon = qscShiftLonOrigin(lon, +support.PiOverTwo)
+		} else if Q.face == QscFaceBack {
+			lon = qscShiftLonOrigin(lon, +support.Pi)
+		} else if Q.face == QscFaceLeft {
+			lon = qscShiftLonOrigin(lon, -support.PiOverTwo)
+		}
+		sinlat, coslat := math.Sincos(lat)
+		sinlon, coslon := math.Sincos(lon)
+		q := coslat * coslon
+		r := coslat * sinlon
+		s := sinlat
+
+		switch Q.face {
+		case QscFaceFront:
+			phi = math.Acos(q)
+			theta, area = qscFwdEquatFaceTheta(phi, s, r)
+		case QscFaceRight:
+			phi = math.Acos(r)
+			theta, area = qscFwdEquatFaceTheta(phi, s, -q)
+		case QscFaceBack:
+			phi = math.Acos(-q)
+			theta, area = qscFwdEquatFaceTheta(phi, s, -r)
+		case QscFaceLeft:
+			phi = math.Acos(-r)
+			theta, area = qscFwdEquatFaceTheta(phi, s, q)
+		}
+	}
+
+	/* Compute mu and nu for the area of definition.
+	 * For mu, see Eq. (3-21) in [OL76], but note the typos:
+	 * compare with Eq. (3-14). For nu, see Eq. (3-38). */
+	mu := math.Atan((12.0 / support.Pi) * (theta + math.Acos(math.Sin(theta)*math.Cos(support.PiOverFour)) - support.PiOverTwo))
+	t := math.Sqrt((1.0 - math.Cos(phi)) / (math.Cos(mu) * math.Cos(mu)) / (1.0 - math.Cos(math.Atan(1.0/math.Cos(theta)))))
+
+	/* Apply the result to the real area. */
+	if area == qscArea1 {
+		mu += support.PiOverTwo
+	} else if area == qscArea2 {
+		mu += support.Pi
+	} else if area == qscArea3 {
+		mu += support.Pi + support.PiOverTwo
+	}
+
+	/* Now compute x, y from mu and nu */
+	xy.X = t * math.Cos(mu)
+	xy.Y = t * math.Sin(mu)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Qsc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+	PE := op.System.Ellipsoid
+
+	var area qscArea
+
+	/* Convert the input x, y to the mu and nu angles as used by QSC.
+	 * This depends on the area of the cube face. */
+	nu := math.Atan(math.Sqrt(xy.X*xy.X + xy.Y*xy.Y))
+	mu := math.Atan2(xy.Y, xy.X)
+	if xy.X >= 0.0 && xy.X >= math.Abs(xy.Y) {
+		area = qscArea0
+	} else if xy.Y >= 0.0 && xy.Y >= math.Abs(xy.X) {
+		area = qscArea1
+		mu -= support.PiOverTwo
+	} else if xy.X < 0.0 && -xy.X >= math.Abs(xy.Y) {
+		area = qscArea2
+		if mu < 0.0 {
+			mu += support.Pi
+		} else {
+			mu -= support.Pi
+		}
+	} else {
+		area = qscArea3
+		mu += support.PiOverTwo
+	}
+
+	/* Compute phi and theta for the area of definition.
+	 * The inverse projection is not described in the original paper, but some
+	 * good hints can be found here (as of 2011-12-14):
+	 * http://fits.gsfc.nasa.gov/fitsbits/saf.93/saf.9302
+	 * (search for "Message-Id: <9302181759.AA25477 at fits.cv.nrao.edu>") */
+	t := (support.Pi / 12.0) * math.Tan(mu)
+	tantheta := math.Sin(t) / (math.Cos(t) - (1.0 / math.Sqrt(2.0)))
+	theta := math.Atan(tantheta)
+	cosmu := math.Cos(mu)
+	tannu := math.Tan(nu)
+	cosphi := 1.0 - cosmu*cosmu*tannu*tannu*(1.0-math.Cos(math.Atan(1.0/math.Cos(theta))))
+	if cosphi < -1.0 {
+		cosphi = -1.0
+	} else if cosphi > +1.0 {
+		cosphi = +1.0
+	}
+
+	/* Apply the result to the real area on the cube face.
+	 * For the top and bottom face, we can compute phi and lam directly.
+	 * For the other faces, we must use unit sphere cartesian coordinates
+	 * as an intermediate step. */
+	if Q.face == QscFaceTop {
+		phi := math.Acos(cosphi)
+		lp.Phi = support.PiOverTwo - phi
+		switch area {
+		case qscArea0:
+			lp.Lam = theta + support.PiOverTwo
+		case qscArea1:
+			if theta < 0.0 {
+				lp.Lam = theta + support.Pi
+			} else {
+				lp.Lam = theta - support.Pi
+			}
+		case qscArea2:
+			lp.Lam = theta - support.PiOverTwo
+		default:
+			lp.Lam = theta
+		}
+	} else if Q.face == QscFaceBottom {
+		phi := math.Acos(cosphi)
+		lp.Phi = phi - support.PiOverTwo
+		switch area {
+		case qscArea0:
+			lp.Lam = -theta + support.PiOverTwo
+		case qscArea1:
+			lp.Lam = -theta
+		case qscArea2:
+			lp.Lam = -theta - support.PiOverTwo
+		default:
+			if theta < 0.0 {
+				lp.Lam = -theta - support.Pi
+			} else {
+				lp.Lam = -theta + support.Pi
+			}
+		}
+	} else {
+		/* Compute phi and lam via cartesian unit sphere coordinates. */
+		var r, s float64
+		q := cosphi
+		t = q * q
+		if t >= 1.0 {
+			s = 0.0
+		} else {
+			s = math.Sqrt(1.0-t) * math.Sin(theta)
+		}
+		t += s * s
+		if t >= 1.0 {
+			r = 0.0
+		} else {
+			r = math.Sqrt(1.0 - t)
+		}
+		/* Rotate q,r,s into the correct area. */
+		switch area {
+		case qscArea1:
+			r, s = -s, r
+		case qscArea2:
+			r = -r
+			s = -s
+		case qscArea3:
+			r, s = s, -r
+		}
+		/* Rotate q,r,s into the correct cube face. */
+		switch Q.face {
+		case QscFaceRight:
+			q, r = -r, q
+		case QscFaceBack:
+			q = -q
+			r = -r
+		case QscFaceLeft:
+			q, r = r, -q
+		}
+		/* Now compute phi and lam from the unit sphere coordinates. */
+		lp.Phi = math.Acos(-s) - support.PiOverTwo
+		lp.Lam = math.Atan2(r, q)
+		switch Q.face {
+		case QscFaceRight:
+			lp.Lam = qscShiftLonOrigin(lp.Lam, -support.PiOverTwo)
+		case QscFaceBack:
+			lp.Lam = qscShiftLonOrigin(lp.Lam, -support.Pi)
+		case QscFaceLeft:
+			lp.Lam = qscShiftLonOrigin(lp.Lam, +support.PiOverTwo)
+		}
+	}
+
+	/* Apply the shift from the sphere to the ellipsoid as described
+	 * in [LK12]. */
+	if PE.Es != 0.0 {
+		invertSign := lp.Phi < 0.0
+		tanphi := math.Tan(lp.Phi)
+		xa := Q.b / math.Sqrt(tanphi*tanphi+Q.oneMinusFSquared)
+		lp.Phi = math.Atan(math.Sqrt(Q.aSquared-xa*xa) / (Q.oneMinusF * xa))
+		if invertSign {
+			lp.Phi = -lp.Phi
+		}
+	}
+	return lp, nil
+}
+
+func (op *Qsc) setup(sys *core.System) {
+	Q := op
+	P := op.System
+	PE := P.Ellipsoid
+
+	/* Determine the cube face from the center of projection. */
+	if P.Phi0 >= support.PiOverTwo-support.PiOverFour/2.0 {
+		Q.face = QscFaceTop
+	} else if P.Phi0 <= -(support.PiOverTwo - support.PiOverFour/2.0) {
+		Q.face = QscFaceBottom
+	} else if math.Abs(P.Lam0) <= support.PiOverFour {
+		Q.face = QscFaceFront
+	} else if math.Abs(P.Lam0) <= support.PiOverTwo+support.PiOverFour {
+		if P.Lam0 > 0.0 {
+			Q.face = QscFaceRight
+		} else {
+			Q.face = QscFaceLeft
+		}
+	} else {
+		Q.face = QscFaceBack
+	}
+
+	/* Fill in useful values for the ellipsoid <-> sphere shift
+	 * described in [LK12]. */
+	if PE.Es != 0.0 {
+		Q.aSquared = PE.A * PE.A
+		Q.b = PE.A * math.Sqrt(1.0-PE.Es)
+		Q.oneMinusF = 1.0 - (PE.A-Q.b)/PE.A
+		Q.oneMinusFSquared = Q.oneMinusF * Q.oneMinusF
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("robin",
+		"Robinson",
+		"\n\tPCyl, Sph",
+		NewRobin,
+	)
+}
+
+// Robin implements core.IOperation and core.ConvertLPToXY
+type Robin struct {
+	core.Operation
+}
+
+// robinCoefs holds the cubic interpolation coefficients for one table node
+//
+// Note: the original table was defined with single precision floats, and
+// the published test values depend on that, so we keep them as float32.
+type robinCoefs struct {
+	c0, c1, c2, c3 float32
+}
+
+var robinX = []robinCoefs{
+	{1.0, 2.2199e-17, -7.15515e-05, 3.1103e-06},
+	{0.9986, -0.000482243, -2.4897e-05, -1.3309e-06},
+	{0.9954, -0.00083103, -4.48605e-05, -9.86701e-07},
+	{0.99, -0.00135364, -5.9661e-05, 3.6777e-06},
+	{0.9822, -0.00167442, -4.49547e-06, -5.72411e-06},
+	{0.973, -0.00214868, -9.03571e-05, 1.8736e-08},
+	{0.96, -0.00305085, -9.00761e-05, 1.64917e-06},
+	{0.9427, -0.00382792, -6.53386e-05, -2.6154e-06},
+	{0.9216, -0.00467746, -0.00010457, 4.81243e-06},
+	{0.8962, -0.00536223, -3.23831e-05, -5.43432e-06},
+	{0.8679, -0.00609363, -0.000113898, 3.32484e-06},
+	{0.835, -0.00698325, -6.40253e-05, 9.34959e-07},
+	{0.7986, -0.00755338, -5.00009e-05, 9.35324e-07},
+	{0.7597, -0.00798324, -3.5971e-05, -2.27626e-06},
+	{0.7186, -0.00851367, -7.01149e-05, -8.6303e-06},
+	{0.6732, -0.00986209, -0.000199569, 1.91974e-05},
+	{0.6213, -0.010418, 8.83923e-05, 6.24051e-06},
+	{0.5722, -0.00906601, 0.000182, 6.24051e-06},
+	{0.5322, -0.00677797, 0.000275608, 6.24051e-06},
+}
+
+var robinY = []robinCoefs{
+	{-5.20417e-18, 0.0124, 1.21431e-18, -8.45284e-11},
+	{0.062, 0.0124, -1.26793e-09, 4.22642e-10},
+	{0.124, 0.0124, 5.07171e-09, -1.60604e-09},
+	{0.186, 0.0123999, -1.90189e-08, 6.00152e-09},
+	{0.248, 0.0124002, 7.10039e-08, -2.24e-08},
+	{0.31, 0.0123992, -2.64997e-07, 8.35986e-08},
+	{0.372, 0.0124029, 9.88983e-07, -3.11994e-07},
+	{0.434, 0.0123893, -3.69093e-06, -4.35621e-07},
+	{0.4958, 0.0123198, -1.02252e-05, -3.45523e-07},
+	{0.5571, 0.0121916, -1.54081e-05, -5.82288e-07},
+	{0.6176, 0.0119938, -2.41424e-05, -5.25327e-07},
+	{0.6769, 0.011713, -3.20223e-05, -5.16405e-07},
+	{0.7346, 0.0113541, -3.97684e-05, -6.09052e-07},
+	{0.7903, 0.0109107, -4.89042e-05, -1.04739e-06},
+	{0.8435, 0.0103431, -6.4615e-05, -1.40374e-09},
+	{0.8936, 0.00969686, -6.4636e-05, -8.547e-06},
+	{0.9394, 0.00840947, -0.000192841, -4.2106e-06},
+	{0.9761, 0.00616527, -0.000256, -4.2106e-06},
+	{1.0, 0.00328947, -0.000319159, -4.2106e-06},
+}
+
+const robinFXC = 0.8487
+const robinFYC = 1.3523
+const robinC1 = 11.45915590261646417544
+const robinRC1 = 0.08726646259971647884
+const robinNodes = 18
+const robinOneEps = 1.000001
+const robinEps = 1e-10
+const robinMaxIter = 100
+
+func (c robinCoefs) v(z float64) float64 {
+	return float64(c.c0) + z*(float64(c.c1)+z*(float64(c.c2)+z*float64(c.c3)))
+}
+
+func (c robinCoefs) dv(z float64) float64 {
+	return float64(c.c1) + z*(float64(c.c2)+float64(c.c2)+z*3.*float64(c.c3))
+}
+
+// NewRobin returns a new Robinson
+func NewRobin(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Robin{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Robin) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if math.IsNaN(lp.Phi) {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	dphi := math.Abs(lp.Phi)
+	i := int(math.Floor(dphi*robinC1 + 1e-15))
+	if i < 0 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	if i >= robinNodes {
+		i = robinNodes
+	}
+	dphi = support.RToDD(dphi - robinRC1*float64(i))
+	xy.X = robinX[i].v(dphi) * robinFXC * lp.Lam
+	xy.Y = robinY[i].v(dphi) * robinFYC
+	if lp.Phi < 0. {
+		xy.Y = -xy.Y
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Robin) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Lam = xy.X / robinFXC
+	lp.Phi = math.Abs(xy.Y / robinFYC)
+	if lp.Phi >= 1. { /* simple pathologic cases */
+		if lp.Phi > robinOneEps {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+		if xy.Y < 0. {
+			lp.Phi = -support.PiOverTwo
+		} else {
+			lp.Phi = support.PiOverTwo
+		}
+		lp.Lam /= float64(robinX[robinNodes].c0)
+		return lp, nil
+	}
+
+	/* general problem */
+	/* in Y space, reduce to table interval */
+	if math.IsNaN(lp.Phi) {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	i := int(math.Floor(lp.Phi * robinNodes))
+	if i < 0 || i >= robinNodes {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	for {
+		if float64(robinY[i].c0) > lp.Phi {
+			i--
+		} else if float64(robinY[i+1].c0) <= lp.Phi {
+			i++
+		} else {
+			break
+		}
+	}
+	T := robinY[i]
+	/* first guess, linear interp */
+	t := 5. * (lp.Phi - float64(T.c0)) / float64(robinY[i+1].c0-T.c0)
+	/* make into root */
+	T.c0 = float32(float64(T.c0) - lp.Phi)
+	iters := robinMaxIter
+	for ; iters > 0; iters-- { /* Newton-Raphson */
+		t1 := T.v(t) / T.dv(t)
+		t -= t1
+		if math.Abs(t1) < robinEps {
+			break
+		}
+	}
+	if iters == 0 {
+		return nil, merror.New(merror.NonConvergent)
+	}
+	lp.Phi = support.DDToR(5*float64(i) + t)
+	if xy.Y < 0. {
+		lp.Phi = -lp.Phi
+	}
+	lp.Lam /= robinX[i].v(t)
+	return lp, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("euler",
+		"Euler",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewEuler,
+	)
+	core.RegisterConvertLPToXY("murd1",
+		"Murdoch I",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewMurd1,
+	)
+	core.RegisterConvertLPToXY("murd2",
+		"Murdoch II",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewMurd2,
+	)
+	core.RegisterConvertLPToXY("murd3",
+		"Murdoch III",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewMurd3,
+	)
+	core.RegisterConvertLPToXY("pconic",
+		"Perspective Conic",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewPconic,
+	)
+	core.RegisterConvertLPToXY("tissot",
+		"Tissot",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewTissot,
+	)
+	core.RegisterConvertLPToXY("vitk1",
+		"Vitkovsky I",
+		"\n\tConic, Sph\n\tlat_1= and lat_2=",
+		NewVitk1,
+	)
+}
+
+type sconicsType int
+
+const (
+	sconicsEuler sconicsType = iota
+	sconicsMurd1
+	sconicsMurd2
+	sconicsMurd3
+	sconicsPconic
+	sconicsTissot
+	sconicsVitk1
+)
+
+// Sconics implements core.IOperation and core.ConvertLPToXY
+type Sconics struct {
+	core.Operation
+	n     float64
+	rhoC  float64
+	rho0  float64
+	sig   float64
+	c1    float64
+	c2    float64
+	stype sconicsType
+}
+
+// NewEuler returns a new Euler
+func NewEuler(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsEuler)
+}
+
+// NewMurd1 returns a new Murdoch I
+func NewMurd1(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsMurd1)
+}
+
+// NewMurd2 returns a new Murdoch II
+func NewMurd2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsMurd2)
+}
+
+// NewMurd3 returns a new Murdoch III
+func NewMurd3(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsMurd3)
+}
+
+// NewPconic returns a new Perspective Conic
+func NewPconic(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsPconic)
+}
+
+// NewTissot returns a new Tissot
+func NewTissot(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsTissot)
+}
+
+// NewVitk1 returns a new Vitkovsky I
+func NewVitk1(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newSconics(system, sconicsVitk1)
+}
+
+func newSconics(system *core.System, stype sconicsType) (core.IConvertLPToXY, error) {
+	op := &Sconics{
+		stype: stype,
+	}
+	op.System = system
+
+	err := op.setup()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Sconics) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+	Q := op
+
+	var rho float64
+	switch Q.stype {
+	case sconicsMurd2:
+		rho = Q.rhoC + math.Tan(Q.sig-lp.Phi)
+	case sconicsPconic:
+		rho = Q.c2 * (Q.c1 - math.Tan(lp.Phi-Q.sig))
+	default:
+		rho = Q.rhoC - lp.Phi
+	}
+	lp.Lam *= Q.n
+	xy.X = rho * math.Sin(lp.Lam)
+	xy.Y = Q.rho0 - rho*math.Cos(lp.Lam)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Sconics) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+	Q := op
+
+	xy.Y = Q.rho0 - xy.Y
+	rho := math.Hypot(xy.X, xy.Y)
+	if Q.n < 0. {
+		rho = -rho
+		xy.X = -xy.X
+		xy.Y = -xy.Y
+	}
+
+	lp.Lam = math.Atan2(xy.X, xy.Y) / Q.n
+
+	switch Q.stype {
+	case sconicsPconic:
+		lp.Phi = math.Atan(Q.c1-rho/Q.c2) + Q.sig
+	case sconicsMurd2:
+		lp.Phi = Q.sig - math.Atan(rho-Q.rhoC)
+	default:
+		lp.Phi = Q.rhoC - rho
+	}
+	return lp, nil
+}
+
+func (op *Sconics) setup() error {
+	Q := op
+	P := op.System
+	ps := P.ProjString
+
+	if !ps.ContainsKey("lat_1") || !ps.ContainsKey("lat_2") {
+		return merror.New(merror.Lat1OrLat2Missing)
+	}
+	lat1, _ := ps.GetAsFloat("lat_1")
+	lat2, _ := ps.GetAsFloat("lat_2")
+	p1 := support.DDToR(lat1)
+	p2 := support.DDToR(lat2)
+	del := 0.5 * (p2 - p1)
+	Q.sig = 0.5 * (p2 + p1)
+	if math.Abs(del) < eps10 || math.Abs(Q.sig) < eps10 {
+		return merror.New(merror.InvalidLat1OrLat2)
+	}
+
+	var cs float64
+	switch Q.stype {
+	case sconicsTissot:
+		Q.n = math.Sin(Q.sig)
+		cs = math.Cos(del)
+		Q.rhoC = Q.n/cs + cs/Q.n
+		Q.rho0 = math.Sqrt((Q.rhoC - 2*math.Sin(P.Phi0)) / Q.n)
+	case sconicsMurd1:
+		Q.rhoC = math.Sin(del)/(del*math.Tan(Q.sig)) + Q.sig
+		Q.rho0 = Q.rhoC - P.Phi0
+		Q.n = math.Sin(Q.sig)
+	case sconicsMurd2:
+		cs = math.Sqrt(math.Cos(del))
+		Q.rhoC = cs / math.Tan(Q.sig)
+		Q.rho0 = Q.rhoC + math.Tan(Q.sig-P.Phi0)
+		Q.n = math.Sin(Q.sig) * cs
+	case sconicsMurd3:
+		Q.rhoC = del/(math.Tan(Q.sig)*math.Tan(del)) + Q.sig
+		Q.rho0 = Q.rhoC - P.Phi0
+		Q.n = math.Sin(Q.sig) * math.Sin(del) * math.Tan(del) / (del * del)
+	case sconicsEuler:
+		Q.n = math.Sin(Q.sig) * math.Sin(del) / del
+		del *= 0.5
+		Q.rhoC = del/(math.Tan(del)*math.Tan(Q.sig)) + Q.sig
+		Q.rho0 = Q.rhoC - P.Phi0
+	case sconicsPconic:
+		Q.n = math.Sin(Q.sig)
+		Q.c2 = math.Cos(del)
+		Q.c1 = 1. / math.Tan(Q.sig)
+		del = P.Phi0 - Q.sig
+		if math.Abs(del)-eps10 >= support.PiOverTwo {
+			return merror.New(merror.ToleranceCondition)
+		}
+		Q.rho0 = Q.c2 * (Q.c1 - math.Tan(del))
+	case sconicsVitk1:
+		cs = math.Tan(del)
+		Q.n = cs * math.Sin(Q.sig) / del
+		Q.rhoC = del/(cs*math.Tan(Q.sig)) + Q.sig
+		Q.rho0 = Q.rhoC - P.Phi0
+	}
+
+	P.Ellipsoid.Es = 0.0
+	return nil
+}
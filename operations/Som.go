@@ -0,0 +1,306 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("lsat",
+		"Space oblique for LANDSAT",
+		"\n\tCyl, Sph&Ell\n\tlsat= path=",
+		NewLsat,
+	)
+	core.RegisterConvertLPToXY("misrsom",
+		"Space oblique for MISR",
+		"\n\tCyl, Sph&Ell\n\tpath=",
+		NewMisrsom,
+	)
+}
+
+const somTol = 1e-7
+
+// 3pi/2 and 5pi/2
+const somPiHalfPi = 4.71238898038468985769
+const somTwoPiHalfPi = 7.85398163397448309616
+
+// Som implements core.IOperation and core.ConvertLPToXY
+//
+// This is the Space Oblique Mercator, as used for the Landsat
+// and MISR satellite paths.
+type Som struct {
+	core.Operation
+	a2, a4, b, c1, c3 float64
+	q, t, u, w        float64
+	p22, sa, ca, xj   float64
+	rlm, rlm2         float64
+}
+
+// NewLsat returns a new Space oblique for LANDSAT
+func NewLsat(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Som{}
+	op.System = system
+
+	P := op.System
+	ps := P.ProjString
+
+	land, _ := ps.GetAsInt("lsat")
+	if land <= 0 || land > 5 {
+		return nil, merror.New(merror.LsatNotInRange)
+	}
+	maxPath := 233
+	if land <= 3 {
+		maxPath = 251
+	}
+	path, _ := ps.GetAsInt("path")
+	if path <= 0 || path > maxPath {
+		return nil, merror.New(merror.PathNotInRange)
+	}
+
+	var alf float64
+	if land <= 3 {
+		P.Lam0 = support.DDToR(128.87) - support.TwoPi/251.*float64(path)
+		op.p22 = 103.2669323
+		alf = support.DDToR(99.092)
+	} else {
+		P.Lam0 = support.DDToR(129.3) - support.TwoPi/233.*float64(path)
+		op.p22 = 98.8841202
+		alf = support.DDToR(98.2)
+	}
+	op.p22 /= 1440.
+
+	rlm := math.Pi * (1./248. + .5161290322580645)
+
+	op.somSetup(alf, rlm)
+
+	return op, nil
+}
+
+// NewMisrsom returns a new Space oblique for MISR
+func NewMisrsom(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Som{}
+	op.System = system
+
+	P := op.System
+	ps := P.ProjString
+
+	path, _ := ps.GetAsInt("path")
+	if path <= 0 || path > 233 {
+		return nil, merror.New(merror.PathNotInRange)
+	}
+
+	P.Lam0 = support.DDToR(129.3056) - support.TwoPi/233.*float64(path)
+	alf := support.DDToR(98.30382)
+	op.p22 = 98.88 / 1440.0
+
+	op.somSetup(alf, 0)
+
+	return op, nil
+}
+
+// somSetup computes the series coefficients, given the inclination
+// of the orbit
+func (op *Som) somSetup(alf float64, rlm float64) {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	Q.sa = math.Sin(alf)
+	Q.ca = math.Cos(alf)
+	if math.Abs(Q.ca) < 1e-9 {
+		Q.ca = 1e-9
+	}
+	esc := PE.Es * Q.ca * Q.ca
+	ess := PE.Es * Q.sa * Q.sa
+	Q.w = (1. - esc) * PE.ROneEs
+	Q.w = Q.w*Q.w - 1.
+	Q.q = ess * PE.ROneEs
+	Q.t = ess * (2. - PE.Es) * PE.ROneEs * PE.ROneEs
+	Q.u = esc * PE.ROneEs
+	Q.xj = PE.OneEs * PE.OneEs * PE.OneEs
+	Q.rlm = rlm
+	Q.rlm2 = Q.rlm + support.TwoPi
+
+	Q.a2, Q.a4, Q.b, Q.c1, Q.c3 = 0., 0., 0., 0., 0.
+	Q.seraz0(0., 1.)
+	for lam := 9.; lam <= 81.0001; lam += 18. {
+		Q.seraz0(lam, 4.)
+	}
+	for lam := 18.; lam <= 72.0001; lam += 18. {
+		Q.seraz0(lam, 2.)
+	}
+	Q.seraz0(90., 1.)
+	Q.a2 /= 30.
+	Q.a4 /= 60.
+	Q.b /= 30.
+	Q.c1 /= 15.
+	Q.c3 /= 45.
+}
+
+// s, the helper term shared by the series and the projection
+func (op *Som) sOf(sdsq float64, coslam float64) float64 {
+	Q := op
+	return Q.p22 * Q.sa * coslam * math.Sqrt((1.+Q.t*sdsq)/
+		((1.+Q.w*sdsq)*(1.+Q.q*sdsq)))
+}
+
+func (op *Som) seraz0(lam float64, mult float64) {
+	Q := op
+
+	lam = support.DDToR(lam)
+	sd := math.Sin(lam)
+	sdsq := sd * sd
+	s := Q.sOf(sdsq, math.Cos(lam))
+
+	d1 := 1. + Q.q*sdsq
+	h := math.Sqrt((1.+Q.q*sdsq)/(1.+Q.w*sdsq)) * ((1.+Q.w*sdsq)/(d1*d1) - Q.p22*Q.ca)
+
+	sq := math.Sqrt(Q.xj*Q.xj + s*s)
+	fc := mult * (h*Q.xj - s*s) / sq
+	Q.b += fc
+	Q.a2 += fc * math.Cos(lam+lam)
+	Q.a4 += fc * math.Cos(lam*4.)
+	fc = mult * s * (h + Q.xj) / sq
+	Q.c1 += fc * math.Cos(lam)
+	Q.c3 += fc * math.Cos(lam*3.)
+}
+
+// Forward goes forewards
+func (op *Som) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	if lp.Phi > support.PiOverTwo {
+		lp.Phi = support.PiOverTwo
+	} else if lp.Phi < -support.PiOverTwo {
+		lp.Phi = -support.PiOverTwo
+	}
+
+	var lampp float64
+	if lp.Phi >= 0. {
+		lampp = support.PiOverTwo
+	} else {
+		lampp = somPiHalfPi
+	}
+	tanphi := math.Tan(lp.Phi)
+
+	var lamt, lamdp float64
+	l := 0
+	for nn := 0; ; {
+		sav := lampp
+		lamtp := lp.Lam + Q.p22*lampp
+		cl := math.Cos(lamtp)
+		var fac float64
+		if cl < 0 {
+			fac = lampp + math.Sin(lampp)*support.PiOverTwo
+		} else {
+			fac = lampp - math.Sin(lampp)*support.PiOverTwo
+		}
+		for l = 50; l > 0; l-- {
+			lamt = lp.Lam + Q.p22*sav
+			c := math.Cos(lamt)
+			if math.Abs(c) < somTol {
+				lamt -= somTol
+			}
+			xlam := (PE.OneEs*tanphi*Q.sa + math.Sin(lamt)*Q.ca) / c
+			lamdp = math.Atan(xlam) + fac
+			if math.Abs(math.Abs(sav)-math.Abs(lamdp)) < somTol {
+				break
+			}
+			sav = lamdp
+		}
+		nn++
+		if l == 0 || nn >= 3 || (lamdp > Q.rlm && lamdp < Q.rlm2) {
+			break
+		}
+		if lamdp <= Q.rlm {
+			lampp = somTwoPiHalfPi
+		} else if lamdp >= Q.rlm2 {
+			lampp = support.PiOverTwo
+		}
+	}
+	if l == 0 {
+		return nil, merror.New(merror.NonConvergent)
+	}
+
+	sp := math.Sin(lp.Phi)
+	phidp := support.Aasin((PE.OneEs*Q.ca*sp - Q.sa*math.Cos(lp.Phi)*
+		math.Sin(lamt)) / math.Sqrt(1.-PE.Es*sp*sp))
+	tanph := math.Log(math.Tan(support.PiOverFour + .5*phidp))
+	sd := math.Sin(lamdp)
+	sdsq := sd * sd
+	s := Q.sOf(sdsq, math.Cos(lamdp))
+	d := math.Sqrt(Q.xj*Q.xj + s*s)
+
+	xy := &core.CoordXY{}
+	xy.X = Q.b*lamdp + Q.a2*math.Sin(2.*lamdp) + Q.a4*
+		math.Sin(lamdp*4.) - tanph*s/d
+	xy.Y = Q.c1*sd + Q.c3*math.Sin(lamdp*3.) + tanph*Q.xj/d
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Som) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+	PE := op.System.Ellipsoid
+
+	var s float64
+	lamdp := xy.X / Q.b
+	for nn := 50; nn > 0; nn-- {
+		sav := lamdp
+		sd := math.Sin(lamdp)
+		sdsq := sd * sd
+		s = Q.sOf(sdsq, math.Cos(lamdp))
+		lamdp = xy.X + xy.Y*s/Q.xj - Q.a2*math.Sin(2.*lamdp) -
+			Q.a4*math.Sin(lamdp*4.) - s/Q.xj*(Q.c1*math.Sin(lamdp)+Q.c3*math.Sin(lamdp*3.))
+		lamdp /= Q.b
+		if math.Abs(lamdp-sav) < somTol {
+			break
+		}
+	}
+
+	sl := math.Sin(lamdp)
+	fac := math.Exp(math.Sqrt(1.+s*s/Q.xj/Q.xj) * (xy.Y -
+		Q.c1*sl - Q.c3*math.Sin(lamdp*3.)))
+	phidp := 2. * (math.Atan(fac) - support.PiOverFour)
+	dd := sl * sl
+	if math.Abs(math.Cos(lamdp)) < somTol {
+		lamdp -= somTol
+	}
+	spp := math.Sin(phidp)
+	sppsq := spp * spp
+	lamt := math.Atan(((1.-sppsq*PE.ROneEs)*math.Tan(lamdp)*
+		Q.ca - spp*Q.sa*math.Sqrt((1.+Q.q*dd)*(1.-sppsq)-sppsq*Q.u)/
+		math.Cos(lamdp)) / (1. - sppsq*(1.+Q.u)))
+
+	sl = -1.
+	if lamt >= 0. {
+		sl = 1.
+	}
+	scl := -1.
+	if math.Cos(lamdp) >= 0. {
+		scl = 1.
+	}
+	lamt -= support.PiOverTwo * (1. - scl) * sl
+
+	lp := &core.CoordLP{}
+	lp.Lam = lamt - Q.p22*lamdp
+	if math.Abs(Q.sa) < somTol {
+		lp.Phi = support.Aasin(spp / math.Sqrt(PE.OneEs*PE.OneEs+PE.Es*sppsq))
+	} else {
+		lp.Phi = math.Atan((math.Tan(lamdp)*math.Cos(lamt) - Q.ca*math.Sin(lamt)) /
+			(PE.OneEs * Q.sa))
+	}
+
+	return lp, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("somerc",
+		"Swiss. Obl. Mercator",
+		"\n\tCyl, Ell\n\tFor CH1903",
+		NewSomerc,
+	)
+}
+
+const somercNIter = 6
+
+// Somerc implements core.IOperation and core.ConvertLPToXY
+type Somerc struct {
+	core.Operation
+	K     float64
+	c     float64
+	hlfE  float64
+	kR    float64
+	cosp0 float64
+	sinp0 float64
+}
+
+// NewSomerc returns a new Somerc
+func NewSomerc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Somerc{}
+	op.System = system
+
+	op.setup(system)
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Somerc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	sp := PE.E * math.Sin(lp.Phi)
+	phip := 2.*math.Atan(math.Exp(Q.c*(math.Log(math.Tan(support.PiOverFour+0.5*lp.Phi))-
+		Q.hlfE*math.Log((1.+sp)/(1.-sp)))+Q.K)) - support.PiOverTwo
+	lamp := Q.c * lp.Lam
+	cp := math.Cos(phip)
+	phipp := support.Aasin(Q.cosp0*math.Sin(phip) - Q.sinp0*cp*math.Cos(lamp))
+	lampp := support.Aasin(cp * math.Sin(lamp) / math.Cos(phipp))
+	xy.X = Q.kR * lampp
+	xy.Y = Q.kR * math.Log(math.Tan(support.PiOverFour+0.5*phipp))
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Somerc) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	Q := op
+	PE := op.System.Ellipsoid
+
+	phipp := 2. * (math.Atan(math.Exp(xy.Y/Q.kR)) - support.PiOverFour)
+	lampp := xy.X / Q.kR
+	cp := math.Cos(phipp)
+	phip := support.Aasin(Q.cosp0*math.Sin(phipp) + Q.sinp0*cp*math.Cos(lampp))
+	lamp := support.Aasin(cp * math.Sin(lampp) / math.Cos(phip))
+	con := (Q.K - math.Log(math.Tan(support.PiOverFour+0.5*phip))) / Q.c
+	i := somercNIter
+	for ; i > 0; i-- {
+		esp := PE.E * math.Sin(phip)
+		delp := (con + math.Log(math.Tan(support.PiOverFour+0.5*phip)) - Q.hlfE*
+			math.Log((1.+esp)/(1.-esp))) *
+			(1. - esp*esp) * math.Cos(phip) * PE.ROneEs
+		phip -= delp
+		if math.Abs(delp) < eps10 {
+			break
+		}
+	}
+	if i == 0 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	lp.Phi = phip
+	lp.Lam = lamp / Q.c
+	return lp, nil
+}
+
+func (op *Somerc) setup(sys *core.System) {
+	Q := op
+	P := op.System
+	PE := op.System.Ellipsoid
+
+	Q.hlfE = 0.5 * PE.E
+	cp := math.Cos(P.Phi0)
+	cp *= cp
+	Q.c = math.Sqrt(1 + PE.Es*cp*cp*PE.ROneEs)
+	sp := math.Sin(P.Phi0)
+	Q.sinp0 = sp / Q.c
+	phip0 := support.Aasin(Q.sinp0)
+	Q.cosp0 = math.Cos(phip0)
+	sp *= PE.E
+	Q.K = math.Log(math.Tan(support.PiOverFour+0.5*phip0)) - Q.c*(math.Log(math.Tan(support.PiOverFour+0.5*P.Phi0))-Q.hlfE*
+		math.Log((1.+sp)/(1.-sp)))
+	Q.kR = P.K0 * math.Sqrt(PE.OneEs) / (1. - sp*sp)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("kav5",
+		"Kavraisky V",
+		"\n\tPCyl, Sph",
+		NewKav5,
+	)
+	core.RegisterConvertLPToXY("qua_aut",
+		"Quartic Authalic",
+		"\n\tPCyl, Sph",
+		NewQuaAut,
+	)
+	core.RegisterConvertLPToXY("fouc",
+		"Foucaut",
+		"\n\tPCyl, Sph",
+		NewFouc,
+	)
+	core.RegisterConvertLPToXY("mbt_s",
+		"McBryde-Thomas Flat-Polar Sine (No. 1)",
+		"\n\tPCyl, Sph",
+		NewMbtS,
+	)
+}
+
+// Sts implements core.IOperation and core.ConvertLPToXY
+//
+// This is the family of sine/tangent series projections, which includes
+// the Kavraisky V, Quartic Authalic, Foucaut and McBryde-Thomas Flat-Polar
+// Sine (No. 1) projections.
+type Sts struct {
+	core.Operation
+	Cx      float64
+	Cy      float64
+	Cp      float64
+	tanMode bool
+}
+
+// NewKav5 returns a new Kavraisky V
+func NewKav5(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Sts{}
+	op.System = system
+
+	op.setup(1.50488, 1.35439, false)
+	return op, nil
+}
+
+// NewQuaAut returns a new Quartic Authalic
+func NewQuaAut(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Sts{}
+	op.System = system
+
+	op.setup(2., 2., false)
+	return op, nil
+}
+
+// NewMbtS returns a new McBryde-Thomas Flat-Polar Sine (No. 1)
+func NewMbtS(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Sts{}
+	op.System = system
+
+	op.setup(1.48875, 1.36509, false)
+	return op, nil
+}
+
+// NewFouc returns a new Foucaut
+func NewFouc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Sts{}
+	op.System = system
+
+	op.setup(2., 2., true)
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Sts) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = op.Cx * lp.Lam * math.Cos(lp.Phi)
+	xy.Y = op.Cy
+	lp.Phi *= op.Cp
+	c := math.Cos(lp.Phi)
+	if op.tanMode {
+		xy.X *= c * c
+		xy.Y *= math.Tan(lp.Phi)
+	} else {
+		xy.X /= c
+		xy.Y *= math.Sin(lp.Phi)
+	}
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Sts) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	xy.Y /= op.Cy
+	if op.tanMode {
+		lp.Phi = math.Atan(xy.Y)
+	} else {
+		lp.Phi = support.Aasin(xy.Y)
+	}
+	c := math.Cos(lp.Phi)
+	lp.Phi /= op.Cp
+	lp.Lam = xy.X / (op.Cx * math.Cos(lp.Phi))
+	if op.tanMode {
+		lp.Lam /= c * c
+	} else {
+		lp.Lam *= c
+	}
+	return lp, nil
+}
+
+func (op *Sts) setup(p, q float64, tanMode bool) {
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	op.Cx = q / p
+	op.Cy = p
+	op.Cp = 1. / q
+	op.tanMode = tanMode
+}
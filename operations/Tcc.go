@@ -0,0 +1,58 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("tcc",
+		"Transverse Central Cylindrical",
+		"\n\tCyl, Sph, no inv.",
+		NewTcc,
+	)
+}
+
+// Tcc implements core.IOperation and core.ConvertLPToXY
+type Tcc struct {
+	core.Operation
+}
+
+// NewTcc returns a new Tcc
+func NewTcc(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Tcc{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Tcc) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	b := math.Cos(lp.Phi) * math.Sin(lp.Lam)
+	bt := 1. - b*b
+	if bt < eps10 {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	xy.X = b / math.Sqrt(bt)
+	xy.Y = math.Atan2(math.Tan(lp.Phi), math.Cos(lp.Lam))
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Tcc) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
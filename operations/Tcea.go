@@ -0,0 +1,63 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("tcea",
+		"Transverse Cylindrical Equal Area",
+		"\n\tCyl, Sph",
+		NewTcea,
+	)
+}
+
+// Tcea implements core.IOperation and core.ConvertLPToXY
+type Tcea struct {
+	core.Operation
+}
+
+// NewTcea returns a new Tcea
+func NewTcea(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Tcea{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Tcea) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	P := op.System
+
+	xy.X = math.Cos(lp.Phi) * math.Sin(lp.Lam) / P.K0
+	xy.Y = P.K0 * (math.Atan2(math.Tan(lp.Phi), math.Cos(lp.Lam)) - P.Phi0)
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Tcea) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	P := op.System
+
+	xy.Y = xy.Y/P.K0 + P.Phi0
+	xy.X *= P.K0
+	t := math.Sqrt(1. - xy.X*xy.X)
+	lp.Phi = math.Asin(t * math.Sin(xy.Y))
+	lp.Lam = math.Atan2(xy.X, t*math.Cos(xy.Y))
+	return lp, nil
+}
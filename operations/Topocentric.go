@@ -0,0 +1,124 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertXYZToENU("topocentric",
+		"Geocentric/Topocentric conversion",
+		"\n\t",
+		NewTopocentric,
+	)
+}
+
+// Topocentric implements core.IOperation and core.ConvertXYZToENU
+//
+// It converts geocentric cartesian (ECEF) coordinates to a local
+// East-North-Up frame. The origin of the frame is given either as
+// ECEF coordinates (X_0, Y_0, Z_0) or as geographic coordinates
+// (lon_0, lat_0, h_0).
+type Topocentric struct {
+	core.Operation
+	x0, y0, z0       float64
+	sinphi0, cosphi0 float64
+	sinlam0, coslam0 float64
+}
+
+// NewTopocentric returns a new Topocentric
+func NewTopocentric(system *core.System, desc *core.OperationDescription) (core.IConvertXYZToENU, error) {
+	op := &Topocentric{}
+	op.System = system
+
+	err := op.setup()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Topocentric) Forward(xyz *core.CoordXYZ) (*core.CoordENU, error) {
+	enu := &core.CoordENU{}
+
+	dX := xyz.X - op.x0
+	dY := xyz.Y - op.y0
+	dZ := xyz.Z - op.z0
+
+	enu.E = -op.sinlam0*dX + op.coslam0*dY
+	enu.N = -op.sinphi0*op.coslam0*dX - op.sinphi0*op.sinlam0*dY + op.cosphi0*dZ
+	enu.U = op.cosphi0*op.coslam0*dX + op.cosphi0*op.sinlam0*dY + op.sinphi0*dZ
+
+	return enu, nil
+}
+
+// Inverse goes backwards
+func (op *Topocentric) Inverse(enu *core.CoordENU) (*core.CoordXYZ, error) {
+	xyz := &core.CoordXYZ{}
+
+	xyz.X = op.x0 - op.sinlam0*enu.E - op.sinphi0*op.coslam0*enu.N + op.cosphi0*op.coslam0*enu.U
+	xyz.Y = op.y0 + op.coslam0*enu.E - op.sinphi0*op.sinlam0*enu.N + op.cosphi0*op.sinlam0*enu.U
+	xyz.Z = op.z0 + op.cosphi0*enu.N + op.sinphi0*enu.U
+
+	return xyz, nil
+}
+
+func (op *Topocentric) setup() error {
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	hasX0 := ps.ContainsKey("X_0")
+	hasY0 := ps.ContainsKey("Y_0")
+	hasZ0 := ps.ContainsKey("Z_0")
+	hasLon0 := ps.ContainsKey("lon_0")
+	hasLat0 := ps.ContainsKey("lat_0")
+	hasH0 := ps.ContainsKey("h_0")
+
+	if (hasX0 || hasY0 || hasZ0) && (hasLon0 || hasLat0 || hasH0) {
+		return merror.New(merror.OriginMutuallyExclusive)
+	}
+	if hasX0 && !(hasY0 && hasZ0) {
+		return merror.New(merror.OriginMissing)
+	}
+	if !hasX0 && !(hasLon0 && hasLat0) {
+		return merror.New(merror.OriginMissing)
+	}
+
+	var lpz *core.CoordLPZ
+	if hasX0 {
+		op.x0, _ = ps.GetAsFloat("X_0")
+		op.y0, _ = ps.GetAsFloat("Y_0")
+		op.z0, _ = ps.GetAsFloat("Z_0")
+
+		// the orientation of the frame comes from the geodetic
+		// coordinates of the origin
+		lpz = geodetic(PE, &core.CoordXYZ{X: op.x0, Y: op.y0, Z: op.z0})
+	} else {
+		h0, _ := ps.GetAsFloat("h_0")
+		lpz = &core.CoordLPZ{Lam: P.Lam0, Phi: P.Phi0, Z: h0}
+
+		xyz := cartesian(PE, lpz)
+		op.x0, op.y0, op.z0 = xyz.X, xyz.Y, xyz.Z
+	}
+
+	op.sinphi0 = math.Sin(lpz.Phi)
+	op.cosphi0 = math.Cos(lpz.Phi)
+	op.sinlam0 = math.Sin(lpz.Lam)
+	op.coslam0 = math.Cos(lpz.Lam)
+
+	P.Left = core.IOUnitsCartesian
+	P.Right = core.IOUnitsCartesian
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("tpeqd",
+		"Two Point Equidistant",
+		"\n\tMisc Sph\n\tlat_1= lon_1= lat_2= lon_2=",
+		NewTpeqd,
+	)
+}
+
+// Tpeqd implements core.IOperation and core.ConvertLPToXY
+type Tpeqd struct {
+	core.Operation
+	cp1, sp1, cp2, sp2 float64
+	ccs, cs, sc        float64
+	r2z0, z02, dlam2   float64
+	hz0, thz0, rhshz0  float64
+	ca, sa, lp, lamc   float64
+}
+
+// NewTpeqd returns a new Tpeqd
+func NewTpeqd(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Tpeqd{}
+	op.System = system
+
+	err := op.tpeqdSetup(system)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Tpeqd) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	Q := op
+
+	sp := math.Sin(lp.Phi)
+	cp := math.Cos(lp.Phi)
+	dl1 := lp.Lam + Q.dlam2
+	dl2 := lp.Lam - Q.dlam2
+	z1 := support.Aacos(Q.sp1*sp + Q.cp1*cp*math.Cos(dl1))
+	z2 := support.Aacos(Q.sp2*sp + Q.cp2*cp*math.Cos(dl2))
+	z1 *= z1
+	z2 *= z2
+
+	xy := &core.CoordXY{}
+	t := z1 - z2
+	xy.X = Q.r2z0 * t
+	t = Q.z02 - t
+	xy.Y = Q.r2z0 * support.Asqrt(4.*Q.z02*z2-t*t)
+	if (Q.ccs*sp - cp*(Q.cs*math.Sin(dl1)-Q.sc*math.Sin(dl2))) < 0. {
+		xy.Y = -xy.Y
+	}
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Tpeqd) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	Q := op
+
+	cz1 := math.Cos(math.Hypot(xy.Y, xy.X+Q.hz0))
+	cz2 := math.Cos(math.Hypot(xy.Y, xy.X-Q.hz0))
+	s := cz1 + cz2
+	d := cz1 - cz2
+
+	lp := &core.CoordLP{}
+	lp.Lam = -math.Atan2(d, (s * Q.thz0))
+	lp.Phi = support.Aacos(math.Hypot(Q.thz0*s, d) * Q.rhshz0)
+	if xy.Y < 0. {
+		lp.Phi = -lp.Phi
+	}
+
+	// lam--phi now in system relative to P1--P2 base equator
+	sp := math.Sin(lp.Phi)
+	cp := math.Cos(lp.Phi)
+	lp.Lam -= Q.lp
+	s = math.Cos(lp.Lam)
+	lp.Phi = support.Aasin(Q.sa*sp + Q.ca*cp*s)
+	lp.Lam = math.Atan2(cp*math.Sin(lp.Lam), Q.sa*cp*s-Q.ca*sp) + Q.lamc
+
+	return lp, nil
+}
+
+func (op *Tpeqd) tpeqdSetup(sys *core.System) error {
+	Q := op
+	P := op.System
+	ps := sys.ProjString
+
+	// get control point locations
+	phi1, _ := ps.GetAsFloat("lat_1")
+	lam1, _ := ps.GetAsFloat("lon_1")
+	phi2, _ := ps.GetAsFloat("lat_2")
+	lam2, _ := ps.GetAsFloat("lon_2")
+	phi1 = support.DDToR(phi1)
+	lam1 = support.DDToR(lam1)
+	phi2 = support.DDToR(phi2)
+	lam2 = support.DDToR(lam2)
+
+	if phi1 == phi2 && lam1 == lam2 {
+		return merror.New(merror.ControlPointNoDist)
+	}
+
+	P.Lam0 = support.Adjlon(0.5 * (lam1 + lam2))
+	Q.dlam2 = support.Adjlon(lam2 - lam1)
+
+	Q.cp1 = math.Cos(phi1)
+	Q.cp2 = math.Cos(phi2)
+	Q.sp1 = math.Sin(phi1)
+	Q.sp2 = math.Sin(phi2)
+	Q.cs = Q.cp1 * Q.sp2
+	Q.sc = Q.sp1 * Q.cp2
+	Q.ccs = Q.cp1 * Q.cp2 * math.Sin(Q.dlam2)
+	Q.z02 = support.Aacos(Q.sp1*Q.sp2 + Q.cp1*Q.cp2*math.Cos(Q.dlam2))
+	Q.hz0 = .5 * Q.z02
+	A12 := math.Atan2(Q.cp2*math.Sin(Q.dlam2),
+		Q.cp1*Q.sp2-Q.sp1*Q.cp2*math.Cos(Q.dlam2))
+	pp := support.Aasin(Q.cp1 * math.Sin(A12))
+	Q.ca = math.Cos(pp)
+	Q.sa = math.Sin(pp)
+	Q.lp = support.Adjlon(math.Atan2(Q.cp1*math.Cos(A12), Q.sp1) - Q.hz0)
+	Q.dlam2 *= .5
+	Q.lamc = support.PiOverTwo - math.Atan2(math.Sin(A12)*Q.sp1, math.Cos(A12)) - Q.dlam2
+	Q.thz0 = math.Tan(Q.hz0)
+	Q.rhshz0 = .5 / math.Sin(Q.hz0)
+	Q.r2z0 = 0.5 / Q.z02
+	Q.z02 *= Q.z02
+
+	P.Ellipsoid.Es = 0.
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("urm5",
+		"Urmaev V",
+		"\n\tPCyl, Sph, no inv\n\tn= q= alpha=",
+		NewUrm5,
+	)
+}
+
+// Urm5 implements core.IOperation and core.ConvertLPToXY
+type Urm5 struct {
+	core.Operation
+	m   float64
+	rmn float64
+	q3  float64
+	n   float64
+}
+
+// NewUrm5 returns a new Urm5
+func NewUrm5(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Urm5{}
+	op.System = system
+
+	ps := op.System.ProjString
+
+	n, ok := ps.GetAsFloat("n")
+	if !ok || n <= 0. || n > 1. {
+		return nil, merror.New(merror.NOutOfRange)
+	}
+	op.n = n
+
+	q, _ := ps.GetAsFloat("q")
+	op.q3 = q / 3.
+
+	alpha, _ := ps.GetAsFloat("alpha")
+	alpha = support.DDToR(alpha)
+
+	t := op.n * math.Sin(alpha)
+	denom := math.Sqrt(1. - t*t)
+	if denom == 0. {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	op.m = math.Cos(alpha) / denom
+	op.rmn = 1. / (op.m * op.n)
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Urm5) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lp.Phi = support.Aasin(op.n * math.Sin(lp.Phi))
+	t := lp.Phi * lp.Phi
+	xy.X = op.m * lp.Lam * math.Cos(lp.Phi)
+	xy.Y = lp.Phi * (1. + t*op.q3) * op.rmn
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Urm5) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("urmfps",
+		"Urmaev Flat-Polar Sinusoidal",
+		"\n\tPCyl, Sph\n\tn=",
+		NewUrmfps,
+	)
+	core.RegisterConvertLPToXY("wag1",
+		"Wagner I (Kavraisky VI)",
+		"\n\tPCyl, Sph",
+		NewWag1,
+	)
+}
+
+const urmfpsCx = 0.8773826753
+const urmfpsCy = 1.139753528477
+
+// Urmfps implements core.IOperation and core.ConvertLPToXY
+//
+// This is the Urmaev Flat-Polar Sinusoidal family, which includes
+// Wagner I.
+type Urmfps struct {
+	core.Operation
+	n  float64
+	Cy float64
+}
+
+// NewUrmfps returns a new Urmfps
+func NewUrmfps(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Urmfps{}
+	op.System = system
+
+	n, ok := op.System.ProjString.GetAsFloat("n")
+	if !ok || n <= 0. || n > 1. {
+		return nil, merror.New(merror.NOutOfRange)
+	}
+	op.n = n
+
+	op.setup()
+	return op, nil
+}
+
+// NewWag1 returns a new Wagner I
+func NewWag1(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Urmfps{
+		n: 0.8660254037844386467637231707,
+	}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Urmfps) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lp.Phi = support.Aasin(op.n * math.Sin(lp.Phi))
+	xy.X = urmfpsCx * lp.Lam * math.Cos(lp.Phi)
+	xy.Y = op.Cy * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Urmfps) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	xy.Y /= op.Cy
+	lp.Phi = support.Aasin(math.Sin(xy.Y) / op.n)
+	lp.Lam = xy.X / (urmfpsCx * math.Cos(xy.Y))
+	return lp, nil
+}
+
+func (op *Urmfps) setup() {
+	op.Cy = urmfpsCy / op.n
+	op.System.Ellipsoid.Es = 0.
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("vandg",
+		"van der Grinten (I)",
+		"\n\tMisc Sph",
+		NewVandg,
+	)
+}
+
+const vandgTol = 1.e-10
+const vandgThird = .33333333333333333333
+const vandgC227 = .07407407407407407407
+const vandgPi43 = 4.18879020478639098458
+const vandgPiSq = 9.86960440108935861869
+const vandgTPiSq = 19.73920880217871723738
+const vandgHPiSq = 4.93480220054467930934
+
+// Vandg implements core.IOperation and core.ConvertLPToXY
+type Vandg struct {
+	core.Operation
+}
+
+// NewVandg returns a new Vandg
+func NewVandg(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Vandg{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Vandg) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	p2 := math.Abs(lp.Phi / support.PiOverTwo)
+	if (p2 - vandgTol) > 1. {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+	if p2 > 1. {
+		p2 = 1.
+	}
+
+	if math.Abs(lp.Phi) <= vandgTol {
+		xy.X = lp.Lam
+		xy.Y = 0.
+	} else if math.Abs(lp.Lam) <= vandgTol || math.Abs(p2-1.) < vandgTol {
+		xy.X = 0.
+		xy.Y = math.Pi * math.Tan(.5*math.Asin(p2))
+		if lp.Phi < 0. {
+			xy.Y = -xy.Y
+		}
+	} else {
+		al := .5 * math.Abs(math.Pi/lp.Lam-lp.Lam/math.Pi)
+		al2 := al * al
+		g := math.Sqrt(1. - p2*p2)
+		g = g / (p2 + g - 1.)
+		g2 := g * g
+		p2 = g * (2./p2 - 1.)
+		p2 = p2 * p2
+		xy.X = g - p2
+		g = p2 + al2
+		xy.X = math.Pi * (al*xy.X + math.Sqrt(al2*xy.X*xy.X-g*(g2-p2))) / g
+		if lp.Lam < 0. {
+			xy.X = -xy.X
+		}
+		xy.Y = math.Abs(xy.X / math.Pi)
+		xy.Y = 1. - xy.Y*(xy.Y+2.*al)
+		if xy.Y < -vandgTol {
+			return nil, merror.New(merror.ToleranceCondition)
+		}
+		if xy.Y < 0. {
+			xy.Y = 0.
+		} else {
+			xy.Y = math.Sqrt(xy.Y) * math.Pi
+			if lp.Phi < 0. {
+				xy.Y = -xy.Y
+			}
+		}
+	}
+
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Vandg) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	x2 := xy.X * xy.X
+	ay := math.Abs(xy.Y)
+	if ay < vandgTol {
+		lp.Phi = 0.
+		t := x2*x2 + vandgTPiSq*(x2+vandgHPiSq)
+		if math.Abs(xy.X) <= vandgTol {
+			lp.Lam = 0.
+		} else {
+			lp.Lam = .5 * (x2 - vandgPiSq + math.Sqrt(t)) / xy.X
+		}
+		return lp, nil
+	}
+
+	y2 := xy.Y * xy.Y
+	r := x2 + y2
+	r2 := r * r
+	c1 := -math.Pi * ay * (r + vandgPiSq)
+	c3 := r2 + support.TwoPi*(ay*r+math.Pi*(y2+math.Pi*(ay+support.PiOverTwo)))
+	c2 := c1 + vandgPiSq*(r-3.*y2)
+	c0 := math.Pi * ay
+	c2 /= c3
+	al := c1/c3 - vandgThird*c2*c2
+	m := 2. * math.Sqrt(-vandgThird*al)
+	d := vandgC227*c2*c2*c2 + (c0*c0-vandgThird*c2*c1)/c3
+	d = 3. * d / (al * m)
+	t := math.Abs(d)
+	if (t - vandgTol) > 1. {
+		return nil, merror.New(merror.ToleranceCondition)
+	}
+
+	if t > 1. {
+		if d > 0. {
+			d = 0.
+		} else {
+			d = math.Pi
+		}
+	} else {
+		d = math.Acos(d)
+	}
+	lp.Phi = math.Pi * (m*math.Cos(d*vandgThird+vandgPi43) - vandgThird*c2)
+	if xy.Y < 0. {
+		lp.Phi = -lp.Phi
+	}
+	t = r2 + vandgTPiSq*(x2-y2+vandgHPiSq)
+	if math.Abs(xy.X) <= vandgTol {
+		lp.Lam = 0.
+	} else {
+		if t <= 0. {
+			t = 0.
+		} else {
+			t = math.Sqrt(t)
+		}
+		lp.Lam = .5 * (r - vandgPiSq + t) / xy.X
+	}
+
+	return lp, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("vandg2",
+		"van der Grinten II",
+		"\n\tMisc Sph, no inv.",
+		NewVandg2,
+	)
+	core.RegisterConvertLPToXY("vandg3",
+		"van der Grinten III",
+		"\n\tMisc Sph, no inv.",
+		NewVandg3,
+	)
+}
+
+const vandg2Tol = 1e-10
+
+// Vandg2 implements core.IOperation and core.ConvertLPToXY
+//
+// This covers both van der Grinten II and III.
+type Vandg2 struct {
+	core.Operation
+	vdg3 bool
+}
+
+// NewVandg2 returns a new Vandg2
+func NewVandg2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newVandg2(system, false)
+}
+
+// NewVandg3 returns a new van der Grinten III
+func NewVandg3(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	return newVandg2(system, true)
+}
+
+func newVandg2(system *core.System, vdg3 bool) (core.IConvertLPToXY, error) {
+	op := &Vandg2{
+		vdg3: vdg3,
+	}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Vandg2) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	bt := math.Abs((2. / math.Pi) * lp.Phi)
+	ct := 1. - bt*bt
+	if ct < 0. {
+		ct = 0.
+	} else {
+		ct = math.Sqrt(ct)
+	}
+
+	if math.Abs(lp.Lam) < vandg2Tol {
+		xy.X = 0.
+		xy.Y = math.Pi * bt / (1. + ct)
+		if lp.Phi < 0. {
+			xy.Y = -xy.Y
+		}
+		return xy, nil
+	}
+
+	at := 0.5 * math.Abs(math.Pi/lp.Lam-lp.Lam/math.Pi)
+	if op.vdg3 {
+		x1 := bt / (1. + ct)
+		xy.X = math.Pi * (math.Sqrt(at*at+1.-x1*x1) - at)
+		xy.Y = math.Pi * x1
+	} else {
+		x1 := (ct*math.Sqrt(1.+at*at) - at*ct*ct) /
+			(1. + at*at*bt*bt)
+		xy.X = math.Pi * x1
+		xy.Y = math.Pi * math.Sqrt(1.-x1*(x1+2.*at)+vandg2Tol)
+	}
+	if lp.Lam < 0. {
+		xy.X = -xy.X
+	}
+	if lp.Phi < 0. {
+		xy.Y = -xy.Y
+	}
+
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Vandg2) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("vandg4",
+		"van der Grinten IV",
+		"\n\tMisc Sph, no inv.",
+		NewVandg4,
+	)
+}
+
+const vandg4Tol = 1e-10
+
+// Vandg4 implements core.IOperation and core.ConvertLPToXY
+type Vandg4 struct {
+	core.Operation
+}
+
+// NewVandg4 returns a new Vandg4
+func NewVandg4(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Vandg4{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Vandg4) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	if math.Abs(lp.Phi) < vandg4Tol {
+		xy.X = lp.Lam
+		xy.Y = 0.
+		return xy, nil
+	}
+	if math.Abs(lp.Lam) < vandg4Tol || math.Abs(math.Abs(lp.Phi)-support.PiOverTwo) < vandg4Tol {
+		xy.X = 0.
+		xy.Y = lp.Phi
+		return xy, nil
+	}
+
+	bt := math.Abs((2. / math.Pi) * lp.Phi)
+	bt2 := bt * bt
+	ct := 0.5 * (bt*(8.-bt*(2.+bt2)) - 5.) / (bt2 * (bt - 1.))
+	ct2 := ct * ct
+	dt := (2. / math.Pi) * lp.Lam
+	dt = dt + 1./dt
+	dt = math.Sqrt(dt*dt - 4.)
+	if (math.Abs(lp.Lam) - support.PiOverTwo) < 0. {
+		dt = -dt
+	}
+	dt2 := dt * dt
+	x1 := bt + ct
+	x1 *= x1
+	t := bt + 3.*ct
+	ft := x1*(bt2+ct2*dt2-1.) + (1.-bt2)*(bt2*(t*t+4.*ct2)+
+		ct2*(12.*bt*ct+4.*ct2))
+	x1 = (dt*(x1+ct2-1.) + 2.*math.Sqrt(ft)) / (4.*x1 + dt2)
+	xy.X = support.PiOverTwo * x1
+	xy.Y = support.PiOverTwo * math.Sqrt(1.+dt*math.Abs(x1)-x1*x1)
+	if lp.Lam < 0. {
+		xy.X = -xy.X
+	}
+	if lp.Phi < 0. {
+		xy.Y = -xy.Y
+	}
+
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Vandg4) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
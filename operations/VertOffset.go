@@ -0,0 +1,92 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPZToLPZ("vertoffset",
+		"Vertical Offset and Slope",
+		"\n\t",
+		true,
+		NewVertOffset,
+	)
+}
+
+// VertOffset implements core.IOperation and core.ConvertLPZToLPZ
+//
+// This is EPSG method 1046, "Vertical Offset and Slope" (code 9658 is
+// a different method): the height is shifted by dh plus a tilted plane,
+// whose inclinations slope_lat and slope_lon (in arc-seconds) are taken
+// about the point lat_0, lon_0.
+// Longitudes and latitudes are passed through.
+type VertOffset struct {
+	core.Operation
+	slopeLon float64
+	slopeLat float64
+	zoff     float64
+	rho0     float64
+	nu0      float64
+}
+
+// NewVertOffset returns a new VertOffset
+func NewVertOffset(system *core.System, desc *core.OperationDescription) (core.IConvertLPZToLPZ, error) {
+	op := &VertOffset{}
+	op.System = system
+
+	op.setup()
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *VertOffset) Forward(lpz *core.CoordLPZ) (*core.CoordLPZ, error) {
+	ret := &core.CoordLPZ{Lam: lpz.Lam, Phi: lpz.Phi}
+	ret.Z = lpz.Z + op.offset(lpz)
+	return ret, nil
+}
+
+// Inverse goes backwards
+func (op *VertOffset) Inverse(lpz *core.CoordLPZ) (*core.CoordLPZ, error) {
+	ret := &core.CoordLPZ{Lam: lpz.Lam, Phi: lpz.Phi}
+	ret.Z = lpz.Z - op.offset(lpz)
+	return ret, nil
+}
+
+// offset returns the height offset at the given point; the longitude
+// is already relative to lon_0
+func (op *VertOffset) offset(lpz *core.CoordLPZ) float64 {
+	return op.zoff +
+		op.slopeLat*op.rho0*(lpz.Phi-op.System.Phi0) +
+		op.slopeLon*op.nu0*lpz.Lam*math.Cos(lpz.Phi)
+}
+
+func (op *VertOffset) setup() {
+	P := op.System
+	PE := P.Ellipsoid
+	ps := P.ProjString
+
+	slopeLon, _ := ps.GetAsFloat("slope_lon")
+	slopeLat, _ := ps.GetAsFloat("slope_lat")
+	op.slopeLon = support.DDToR(slopeLon / 3600.)
+	op.slopeLat = support.DDToR(slopeLat / 3600.)
+	op.zoff, _ = ps.GetAsFloat("dh")
+
+	// radii of curvature in the meridian and the prime vertical at lat_0
+	sinphi0 := math.Sin(P.Phi0)
+	t := 1. - PE.Es*sinphi0*sinphi0
+	op.rho0 = PE.A * (1. - PE.Es) / (t * math.Sqrt(t))
+	op.nu0 = PE.A / math.Sqrt(t)
+
+	P.Left = core.IOUnitsAngular
+	P.Right = core.IOUnitsAngular
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("wag2",
+		"Wagner II",
+		"\n\tPCyl, Sph",
+		NewWag2,
+	)
+}
+
+const wag2Cx = 0.92483
+const wag2Cy = 1.38725
+const wag2Cp1 = 0.88022
+const wag2Cp2 = 0.88550
+
+// Wag2 implements core.IOperation and core.ConvertLPToXY
+type Wag2 struct {
+	core.Operation
+}
+
+// NewWag2 returns a new Wag2
+func NewWag2(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Wag2{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Wag2) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	lp.Phi = support.Aasin(wag2Cp1 * math.Sin(wag2Cp2*lp.Phi))
+	xy.X = wag2Cx * lp.Lam * math.Cos(lp.Phi)
+	xy.Y = wag2Cy * lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Wag2) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y / wag2Cy
+	lp.Lam = xy.X / (wag2Cx * math.Cos(lp.Phi))
+	lp.Phi = support.Aasin(math.Sin(lp.Phi)/wag2Cp1) / wag2Cp2
+	return lp, nil
+}
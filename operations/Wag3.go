@@ -0,0 +1,64 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/support"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("wag3",
+		"Wagner III",
+		"\n\tPCyl, Sph\n\tlat_ts=",
+		NewWag3,
+	)
+}
+
+const wag3TwoThird = 0.6666666666666666666667
+
+// Wag3 implements core.IOperation and core.ConvertLPToXY
+type Wag3 struct {
+	core.Operation
+	Cx float64
+}
+
+// NewWag3 returns a new Wag3
+func NewWag3(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Wag3{}
+	op.System = system
+
+	latts, _ := op.System.ProjString.GetAsFloat("lat_ts")
+	ts := support.DDToR(latts)
+	op.Cx = math.Cos(ts) / math.Cos(2.*ts/3.)
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Wag3) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.X = op.Cx * lp.Lam * math.Cos(wag3TwoThird*lp.Phi)
+	xy.Y = lp.Phi
+	return xy, nil
+}
+
+// Inverse goes backwards
+func (op *Wag3) Inverse(xy *core.CoordXY) (*core.CoordLP, error) {
+	lp := &core.CoordLP{Lam: 0.0, Phi: 0.0}
+
+	lp.Phi = xy.Y
+	lp.Lam = xy.X / (op.Cx * math.Cos(wag3TwoThird*lp.Phi))
+	return lp, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package operations
+
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+)
+
+func init() {
+	core.RegisterConvertLPToXY("wag7",
+		"Wagner VII",
+		"\n\tMisc Sph, no inv.",
+		NewWag7,
+	)
+}
+
+// Wag7 implements core.IOperation and core.ConvertLPToXY
+type Wag7 struct {
+	core.Operation
+}
+
+// NewWag7 returns a new Wag7
+func NewWag7(system *core.System, desc *core.OperationDescription) (core.IConvertLPToXY, error) {
+	op := &Wag7{}
+	op.System = system
+
+	PE := op.System.Ellipsoid
+	PE.Es = 0.0
+
+	return op, nil
+}
+
+// Forward goes forewards
+func (op *Wag7) Forward(lp *core.CoordLP) (*core.CoordXY, error) {
+	xy := &core.CoordXY{X: 0.0, Y: 0.0}
+
+	xy.Y = 0.90630778703664996 * math.Sin(lp.Phi)
+	theta := math.Asin(xy.Y)
+	ct := math.Cos(theta)
+	lp.Lam /= 3.
+	xy.X = 2.66723 * ct * math.Sin(lp.Lam)
+	D := 1 / (math.Sqrt(0.5 * (1 + ct*math.Cos(lp.Lam))))
+	xy.Y *= 1.24104 * D
+	xy.X *= D
+	return xy, nil
+}
+
+// Inverse is not allowed
+func (*Wag7) Inverse(*core.CoordXY) (*core.CoordLP, error) {
+	return nil, merror.New(merror.NotInvertible)
+}
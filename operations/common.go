@@ -7,6 +7,14 @@
 
 package operations
 
+import (
+	"math"
+
+	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/merror"
+	"github.com/go-spatial/proj/support"
+)
+
 type mode int
 
 const (
@@ -21,3 +29,77 @@ const tol10 = 1.0e-10
 
 const eps7 = 1.0e-7
 const eps10 = 1.e-10
+
+// genericInverse2D inverts a forward function by Newton-Raphson
+// iteration, using a numerically estimated Jacobian
+//
+// lp holds the initial guess, and is updated in place. The result
+// is good when forward(lp) lies within tol of xy.
+func genericInverse2D(forward func(*core.CoordLP) (*core.CoordXY, error),
+	xy *core.CoordXY, lp *core.CoordLP, tol float64) error {
+
+	var invJ00, invJ01, invJ10, invJ11 float64
+
+	for i := 0; i < 15; i++ {
+		approx, err := forward(&core.CoordLP{Lam: lp.Lam, Phi: lp.Phi})
+		if err != nil {
+			return err
+		}
+		deltaX := approx.X - xy.X
+		deltaY := approx.Y - xy.Y
+		if math.Abs(deltaX) < tol && math.Abs(deltaY) < tol {
+			return nil
+		}
+
+		// only recompute the Jacobian when we aren't close to the
+		// result yet
+		if i == 0 || math.Abs(deltaX) > 1e-6 || math.Abs(deltaY) > 1e-6 {
+			dLam := 1e-6
+			if lp.Lam > 0 {
+				dLam = -dLam
+			}
+			xy2, err := forward(&core.CoordLP{Lam: lp.Lam + dLam, Phi: lp.Phi})
+			if err != nil {
+				return err
+			}
+			dXdLam := (xy2.X - approx.X) / dLam
+			dYdLam := (xy2.Y - approx.Y) / dLam
+
+			dPhi := 1e-6
+			if lp.Phi > 0 {
+				dPhi = -dPhi
+			}
+			xy2, err = forward(&core.CoordLP{Lam: lp.Lam, Phi: lp.Phi + dPhi})
+			if err != nil {
+				return err
+			}
+			dXdPhi := (xy2.X - approx.X) / dPhi
+			dYdPhi := (xy2.Y - approx.Y) / dPhi
+
+			det := dXdLam*dYdPhi - dYdLam*dXdPhi
+			if det == 0 {
+				return merror.New(merror.NonConvergent)
+			}
+			invJ00 = dYdPhi / det
+			invJ01 = -dXdPhi / det
+			invJ10 = -dYdLam / det
+			invJ11 = dXdLam / det
+		}
+
+		lp.Lam -= deltaX*invJ00 + deltaY*invJ01
+		lp.Phi -= deltaX*invJ10 + deltaY*invJ11
+
+		if lp.Lam < -math.Pi {
+			lp.Lam = -math.Pi
+		} else if lp.Lam > math.Pi {
+			lp.Lam = math.Pi
+		}
+		if lp.Phi < -support.PiOverTwo {
+			lp.Phi = -support.PiOverTwo
+		} else if lp.Phi > support.PiOverTwo {
+			lp.Phi = support.PiOverTwo
+		}
+	}
+
+	return merror.New(merror.NonConvergent)
+}
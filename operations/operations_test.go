@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/go-spatial/proj/core"
+	"github.com/go-spatial/proj/operations"
 	"github.com/go-spatial/proj/support"
 	"github.com/stretchr/testify/assert"
 )
@@ -117,6 +118,1078 @@ var testdata = []data{
 			{-200, 100, -0.001790493, 0.000895247},
 			{-200, -100, -0.001790493, -0.000895247},
 		},
+	}, {
+		// builtins.gie:4071
+		proj:  "+proj=sinu +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222605.299539466, 110574.388554153},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:4094
+		proj:  "+proj=sinu +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223368.119026632, 111701.072127637},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:2789
+		proj:  "+proj=moll +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 201113.698641813, 124066.283433860},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001988738, 0.000806005},
+		},
+	}, {
+		// builtins.gie:935
+		proj:  "+proj=eck1 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 204680.888202951, 102912.178426065},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001943415, 0.000971702},
+		},
+	}, {
+		// builtins.gie:1022
+		proj:  "+proj=eck4 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 188646.389356416, 132268.540174065},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002120241, 0.000756015},
+		},
+	}, {
+		// builtins.gie:1080
+		proj:  "+proj=eck6 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 197021.605628992, 126640.420733174},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002029979, 0.000789630},
+		},
+	}, {
+		// builtins.gie:3993
+		proj:  "+proj=robin +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 189588.423282508, 107318.530350703},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002109689, 0.000931806},
+		},
+	}, {
+		// builtins.gie:2977
+		proj:  "+proj=natearth +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 194507.265257889, 112508.737358295},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002056383, 0.000888824},
+		},
+	}, {
+		// builtins.gie:3006
+		proj:  "+proj=natearth2 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 189255.172934731, 113022.495810907},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002113449, 0.000884780},
+		},
+	}, {
+		// builtins.gie:328
+		proj:  "+proj=aitoff +R=6400000 +lat_1=0 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223379.458811696, 111706.742883853},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:5130
+		proj:  "+proj=wintri +a=6400000 +lat_1=0 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223390.801533485, 111703.907505745},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:1765
+		proj:  "+proj=hammer +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223373.788703241, 111703.907397767},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:4856
+		proj:  "+proj=wag1 +a=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 195986.781561158, 127310.075060660},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002040721, 0.000785474},
+		},
+	}, {
+		// builtins.gie:4944
+		proj:  "+proj=wag4 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 192801.218662384, 129416.216394803},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002074503, 0.000772683},
+		},
+	}, {
+		// builtins.gie:5031
+		proj:  "+proj=wag7 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 198601.876957312, 125637.045714171},
+		},
+	}, {
+		// builtins.gie:3266
+		proj:  "+proj=omerc +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222650.796885261, 110642.229314984},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:3289
+		proj:  "+proj=omerc +ellps=GRS80 +lat_1=0.5 +lat_2=2 +no_rot",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 110642.229314984, 222650.796885261},
+		},
+		inv: [][]float64{
+			{200, 100, 0.000898315, 0.001808739},
+		},
+	}, {
+		// builtins.gie:4124
+		proj:  "+proj=somerc +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222638.981586547, 110579.965218249},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:4147
+		proj:  "+proj=somerc +R=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 111706.743574944},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// EPSG Guidance Note 7-2, Hotine Oblique Mercator (variant B)
+		proj:  "+proj=omerc +lat_0=4 +lonc=115 +alpha=53.31582047222222 +gamma=53.13010236111111 +k=0.99984 +x_0=590476.87 +y_0=442857.65 +ellps=evrstSS",
+		delta: 0.01,
+		fwd: [][]float64{
+			{115.80550544444444, 5.387253583333334, 679245.73, 596562.78},
+		},
+		inv: [][]float64{
+			{679245.73, 596562.78, 115.80550544444444, 5.387253583333334},
+		},
+	}, {
+		// builtins.gie:2087
+		proj:  "+proj=krovak +ellps=GRS80 +no_defs",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, -3196535.232563641, -6617878.867551444},
+		},
+		inv: [][]float64{
+			{200, 100, 24.836218919, 59.758403933},
+		},
+	}, {
+		// EPSG Guidance Note 7-2, Krovak (south/west oriented, Czech axis order)
+		proj:  "+proj=krovak +ellps=bessel +czech",
+		delta: 0.01,
+		fwd: [][]float64{
+			{16.849771944444445, 50.20901166666667, 568990.99, 1050538.63},
+		},
+		inv: [][]float64{
+			{568990.99, 1050538.63, 16.849771944444445, 50.20901166666667},
+		},
+	}, {
+		// EPSG Guidance Note 7-2, Krovak East North
+		proj:  "+proj=krovak +ellps=bessel +lat_0=49.5 +lon_0=24.83333333333333 +alpha=30.28813975277778 +lat_ts=78.5 +k=0.9999",
+		delta: 0.01,
+		fwd: [][]float64{
+			{16.849771944444445, 50.20901166666667, -568990.99, -1050538.63},
+		},
+		inv: [][]float64{
+			{-568990.99, -1050538.63, 16.849771944444445, 50.20901166666667},
+		},
+	}, {
+		// builtins.gie:538
+		proj:  "+proj=bonne +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222605.296097157, 55321.139565495},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796699, 0.500904369},
+		},
+	}, {
+		// builtins.gie:561
+		proj:  "+proj=bonne +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223368.115572528, 55884.555246394},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790562, 0.500895246},
+		},
+	}, {
+		// builtins.gie:642
+		proj:  "+proj=cass +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222605.285776991, 110642.229253999},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:665
+		proj:  "+proj=cass +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223368.105203484, 111769.145040586},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:3576
+		proj:  "+proj=poly +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222605.285770237, 110642.194561440},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:3599
+		proj:  "+proj=poly +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223368.105210219, 111769.110491225},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:727
+		proj:  "+proj=cc +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 111712.415540593},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:757
+		proj:  "+proj=cea +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222638.981586547, 110568.812396267},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:780
+		proj:  "+proj=cea +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 111695.401198614},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:1386
+		proj:  "+proj=gall +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 157969.171134520, 95345.249178386},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002532140, 0.001048847},
+		},
+	}, {
+		// builtins.gie:2707
+		proj:  "+proj=mill +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 111704.701754394},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:4311
+		proj:  "+proj=tcc +a=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223458.844192458, 111769.145040586},
+		},
+	}, {
+		// builtins.gie:4330
+		proj:  "+proj=tcea +a=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223322.760576727, 111769.145040586},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// EASE-Grid 2.0 Global (EPSG:6933)
+		proj:  "+proj=cea +lat_ts=30 +ellps=WGS84",
+		delta: 0.001,
+		fwd: [][]float64{
+			{10, 50, 964862.802509, 5614050.103061},
+		},
+		inv: [][]float64{
+			{964862.802509, 5614050.103061, 10, 50},
+		},
+	}, {
+		// EPSG:3857
+		proj:  "+proj=webmerc +ellps=WGS84",
+		delta: 0.001,
+		fwd: [][]float64{
+			{10, 50, 1113194.907933, 6446275.841017},
+		},
+		inv: [][]float64{
+			{1113194.907933, 6446275.841017, 10, 50},
+		},
+	}, {
+		// builtins.gie:1140
+		proj:  "+proj=eqdc +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222588.440269286, 110659.134907347},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796359, 0.000904369},
+		},
+	}, {
+		// builtins.gie:1163
+		proj:  "+proj=eqdc +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223351.088175114, 111786.108747174},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790221, 0.000895246},
+		},
+	}, {
+		// builtins.gie:1193
+		proj:  "+proj=euler +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222597.634659108, 111404.240549919},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796281, 0.000898315},
+		},
+	}, {
+		// builtins.gie:1958
+		proj:  "+proj=imw_p +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222588.441139376, 55321.128653810},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796699, 0.500904924},
+		},
+	}, {
+		// builtins.gie:2819
+		proj:  "+proj=murd1 +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222600.813473554, 111404.244180546},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796255, 0.000898315},
+		},
+	}, {
+		// builtins.gie:2872
+		proj:  "+proj=murd2 +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222588.099751230, 111426.140027412},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796357, 0.000897887},
+		},
+	}, {
+		// builtins.gie:2925
+		proj:  "+proj=murd3 +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222600.814077577, 111404.246601372},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796255, 0.000898315},
+		},
+	}, {
+		// builtins.gie:3495
+		proj:  "+proj=pconic +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222588.098841617, 111416.604770067},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796358, 0.000897964},
+		},
+	}, {
+		// builtins.gie:4393
+		proj:  "+proj=tissot +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222641.078699631, 54347.828487281},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796281, 0.513444955},
+		},
+	}, {
+		// builtins.gie:4827
+		proj:  "+proj=vitk1 +a=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223370.224840471, 111786.123319644},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790068, 0.000895246},
+		},
+	}, {
+		// builtins.gie:694, without the axisswap step
+		proj:  "+proj=ccon +R=6390000 +lat_1=52 +lat_0=52 +lon_0=19 +x_0=330000 +y_0=-350000",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{24, 55, 650031.54109413219363, -4106.1617770643609028},
+		},
+		inv: [][]float64{
+			{700000, -700000, 24.027610763560529927, 48.750476070495021286},
+		},
+	}, {
+		// builtins.gie:1450
+		proj:  "+proj=geos +ellps=GRS80 +lat_1=0.5 +lat_2=2 +h=35785831",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222527.070365800, 110551.303413329},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001796631, 0.000904369},
+		},
+	}, {
+		// builtins.gie:1473
+		proj:  "+proj=geos +R=6400000 +lat_1=0.5 +lat_2=2 +h=35785831",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223289.457635795, 111677.657456537},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// GOES-R ABI fixed grid, x sweep
+		proj:  "+proj=geos +h=35786023 +lon_0=-75 +sweep=x +ellps=GRS80",
+		delta: 0.001,
+		fwd: [][]float64{
+			{-80, 30, -468595.773155, 3087367.476236},
+		},
+		inv: [][]float64{
+			{-468595.773155, 3087367.476236, -80, 30},
+		},
+	}, {
+		// builtins.gie:1823
+		proj:  "+proj=healpix +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222390.103949239, 130406.588664482},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001798641, 0.000766795},
+		},
+	}, {
+		// builtins.gie:1846
+		proj:  "+proj=healpix +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 131588.044441999},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000759909},
+		},
+	}, {
+		// builtins.gie:1876
+		proj:  "+proj=rhealpix +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 222390.103949239, 130406.588664482},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001798641, 0.000766795},
+		},
+	}, {
+		// builtins.gie:1899
+		proj:  "+proj=rhealpix +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 131588.044441999},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000759909},
+		},
+	}, {
+		proj:  "+proj=rhealpix +R=6400000 +north_square=1 +south_square=2",
+		delta: 0.001,
+		fwd: [][]float64{
+			{100, 70, -3363628.687960, 12191135.922924},
+			{-150, -80, 5384249.311531, -11126199.688850},
+		},
+		inv: [][]float64{
+			{-3363628.687960, 12191135.922924, 100, 70},
+			{5384249.311531, -11126199.688850, -150, -80},
+		},
+	}, {
+		proj:  "+proj=healpix +R=6400000 +rot_xy=30",
+		delta: 0.001,
+		fwd: [][]float64{
+			{100, 70, 15576753.371021, 146277.896684},
+		},
+		inv: [][]float64{
+			{15576753.371021, 146277.896684, 100, 70},
+		},
+	}, {
+		// builtins.gie:3941
+		proj:  "+proj=qsc +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 304638.450843852, 164123.870923794},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001321341, 0.000610653},
+		},
+	}, {
+		// builtins.gie:3964
+		proj:  "+proj=qsc +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 305863.792402891, 165827.722754715},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001316827, 0.000604493},
+		},
+	}, {
+		proj:  "+proj=qsc +ellps=WGS84 +lat_0=90",
+		delta: 0.001,
+		fwd: [][]float64{
+			{30, 70, 1661787.867402, -2681690.297804},
+		},
+		inv: [][]float64{
+			{1661787.867402, -2681690.297804, 30, 70},
+		},
+	}, {
+		proj:  "+proj=qsc +ellps=WGS84 +lon_0=180",
+		delta: 0.001,
+		fwd: [][]float64{
+			{150, 10, -4365971.805734, 1684111.854555},
+		},
+		inv: [][]float64{
+			{-4365971.805734, 1684111.854555, 150, 10},
+		},
+	}, {
+		// builtins.gie:1654
+		proj:  "+proj=goode +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223368.119026632, 111701.072127637},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:1928
+		proj:  "+proj=igh +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223878.497456271, 111701.072127637},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790489, 0.000895247},
+		},
+	}, {
+		// not in our copy of builtins.gie, which predates igh_o: these are
+		// regression values generated with this package's cmd/proj, and
+		// checked by round trip
+		proj:  "+proj=igh_o +R=6400000",
+		delta: 0.001,
+		fwd: [][]float64{
+			{50, 60, 2787711.759879, 6562388.596359},
+			{-120, -30, -13254477.594965, -3351032.163829},
+			{170, 70, 16560870.317294, 7463176.386461},
+		},
+		inv: [][]float64{
+			{2787711.759879, 6562388.596359, 50, 60},
+			{-13254477.594965, -3351032.163829, -120, -30},
+			{16560870.317294, 7463176.386461, 170, 70},
+		},
+	}, {
+		proj:  "+proj=ob_tran +o_proj=moll +R=6378137.0 +o_lon_p=0 +o_lat_p=0 +lon_0=180",
+		delta: 0.001,
+		fwd: [][]float64{
+			{10, 20, -1384841.18787, 7581707.88240},
+		},
+		inv: [][]float64{
+			{300000, 400000, -42.7562158333, 85.5911341667},
+		},
+	}, {
+		proj:  "+proj=ob_tran +o_proj=eqc +R=6400000 +o_lat_p=40 +o_lon_p=-30 +lon_0=10",
+		delta: 0.001,
+		fwd: [][]float64{
+			{2, 1, -4701164.080717, -5401105.118624},
+			{-2, -1, -5436300.405475, -5529229.050836},
+		},
+		inv: [][]float64{
+			{-4701164.080717, -5401105.118624, 2, 1},
+			{-5436300.405475, -5529229.050836, -2, -1},
+		},
+	}, {
+		// builtins.gie:3142
+		proj:  "+proj=nzmg +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 3352675144.747425100, -7043205391.100243600},
+		},
+		inv: [][]float64{
+			{200000.000000000, 100000.000000000, 175.482086827, -69.422692183},
+		},
+	}, {
+		// builtins.gie:357
+		proj:  "+proj=alsk +ellps=clrk66",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{-160.000000000, 55.000000000, -513253.146950842, -968928.031867943},
+		},
+		inv: [][]float64{
+			{-500000.000000000, -950000.000000000, -159.830804303, 55.183195262},
+		},
+	}, {
+		// builtins.gie:1712
+		proj:  "+proj=gs50 +ellps=clrk66",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{-160.000000000, 65.000000000, -1874628.537740233, 2660907.942291015},
+		},
+		inv: [][]float64{
+			{-1800000.000000000, 2600000.000000000, -157.989285000, 64.851559610},
+		},
+	}, {
+		// builtins.gie:2369
+		proj:  "+proj=lee_os +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, -25564478.952605054, 154490848.828625500},
+		},
+		inv: [][]float64{
+			{200, 100, -164.997479458, -9.998758861},
+		},
+	}, {
+		// builtins.gie:4498
+		proj:  "+proj=tpeqd +ellps=GRS80 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.15 * 0.001,
+		fwd: [][]float64{
+			{2, 1, -27750.758831679, -222599.403691777},
+		},
+		inv: [][]float64{
+			{200, 100, -0.000898556, 1.251796630},
+		},
+	}, {
+		// builtins.gie:810
+		proj:  "+proj=chamb +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.5 * 0.001,
+		fwd: [][]float64{
+			{2, 1, -27864.779586801, -223364.324593274},
+		},
+	}, {
+		// builtins.gie:4713
+		proj:  "+proj=vandg +a=6400000 +lat_1=0.5 +lat_2=2 +no_defs",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223395.249543407, 111704.596633675},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790494, 0.000895247},
+		},
+	}, {
+		// builtins.gie:2398
+		proj:  "+proj=loxim +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223382.295791339, 55850.536063819},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790561, 0.500895247},
+		},
+	}, {
+		// builtins.gie:447
+		proj:  "+proj=bacon +a=6400000 +lat_1=0 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223334.132555965, 175450.725922666},
+		},
+	}, {
+		// builtins.gie:3093
+		proj:  "+proj=nicol +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223374.561814140, 111732.553988545},
+		},
+	}, {
+		// builtins.gie:4784
+		proj:  "+proj=vandg4 +R=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223374.577294355, 111701.195484154},
+		},
+	}, {
+		// builtins.gie:2199
+		proj:  "+proj=lagrng +a=6400000 +W=2 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 111703.375917226, 27929.831908033},
+		},
+		inv: [][]float64{
+			{554651.741170, 1111792.298187, 10, 20},
+			{-6925258.661941, -3677541.798077, -120, -45},
+		},
+	}, {
+		// builtins.gie:2428
+		proj:  "+proj=lsat +ellps=GRS80 +lat_1=0.5 +lat_2=2 +lsat=1 +path=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 18241950.014558550, 9998256.839822935},
+		},
+		inv: [][]float64{
+			{200, 100, 126.000423835, 0.001723782},
+		},
+	}, {
+		// builtins.gie:2760
+		proj:  "+proj=misrsom +R=6400000 +lat_1=0.5 +lat_2=2 +path=1",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 18641249.279170386, 9563342.532334166},
+		},
+		inv: [][]float64{
+			{200, 100, 127.759505148, 0.001716231},
+		},
+	}, {
+		// builtins.gie:3547
+		proj:  "+proj=patterson +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 113354.250397780},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000882190},
+		},
+	}, {
+		// eqearth and bertin1953 are newer than our copy of builtins.gie:
+		// these are regression values generated with this package's
+		// cmd/proj, and checked by round trip
+		proj:  "+proj=eqearth +ellps=WGS84",
+		delta: 0.001,
+		fwd: [][]float64{
+			{20, 45, 1643608.305444, 5466867.760214},
+			{-100, -60, -7225128.360577, -6924035.137553},
+			{180, 90, 10216474.793457, 8392927.598466},
+		},
+		inv: [][]float64{
+			{1643608.305444, 5466867.760214, 20, 45},
+			{-7225128.360577, -6924035.137553, -100, -60},
+		},
+	}, {
+		// also matches the spherical formulas of Šavrič, Patterson and
+		// Jenny (2018), "The Equal Earth map projection", evaluated by hand
+		proj:  "+proj=eqearth +R=6400000",
+		delta: 0.001,
+		fwd: [][]float64{
+			{-170, -20, -15886661.487717, -2565281.392400},
+		},
+		inv: [][]float64{
+			{-15886661.487717, -2565281.392400, -170, -20},
+		},
+	}, {
+		// regression values from cmd/proj, as for eqearth
+		proj:  "+proj=bertin1953 +R=6400000",
+		delta: 0.001,
+		fwd: [][]float64{
+			{20, 45, 232220.382694, 340738.837380},
+			{-100, -60, -11468300.031191, -4475371.359300},
+			{-170, -20, 15815959.550063, 3268044.691941},
+		},
+		inv: [][]float64{
+			{232220.382694, 340738.837380, 20, 45},
+			{-11468300.031191, -4475371.359300, -100, -60},
+			{15815959.550063, 3268044.691941, -170, -20},
+		},
+	}, {
+		// builtins.gie:3657
+		proj:  "+proj=putp2 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 211638.039634339, 117895.033043380},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001889802, 0.000848202},
+		},
+	}, {
+		// builtins.gie:3831
+		proj:  "+proj=putp6 +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 226369.395133403, 110218.523796521},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001766713, 0.000907296},
+		},
+	}, {
+		// builtins.gie:2480
+		proj:  "+proj=mbt_s +a=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 204831.240570992, 121816.466696035},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001952689, 0.000820885},
+		},
+	}, {
+		// builtins.gie:2567
+		proj:  "+proj=mbtfpq +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 209391.854738393, 119161.040199055},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001910106, 0.000839185},
+		},
+	}, {
+		// builtins.gie:1357
+		proj:  "+proj=fouc_s +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 223402.144255274, 111695.401198614},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895247},
+		},
+	}, {
+		// builtins.gie:1794
+		proj:  "+proj=hatano +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 189878.878946528, 131409.802440626},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002106462, 0.000760957},
+		},
+	}, {
+		// builtins.gie:4654
+		proj:  "+proj=urmfps +a=6400000 +lat_1=0.5 +lat_2=2 +n=0.5",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 196001.708134192, 127306.843329993},
+		},
+		inv: [][]float64{
+			{200, 100, 0.002040721, 0.000785474},
+		},
+	}, {
+		// builtins.gie:518
+		proj:  "+proj=boggs +a=6400000 +lat_1=0 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 211949.700808182, 117720.998305411},
+		},
+	}, {
+		// builtins.gie:466
+		proj:  "+proj=bipc +ellps=GRS80 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 2452160.217725756, -14548450.759654747},
+		},
+		inv: [][]float64{
+			{200, 100, -73.038700285, 17.248118466},
+		},
+	}, {
+		// builtins.gie:590
+		proj:  "+proj=calcofi +ellps=GRS80 +lat_1=0.5 +lat_2=2 +no_defs",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 508.444872150, -1171.764860418},
+		},
+		inv: [][]float64{
+			{200, 100, -110.363307925, 12.032056976},
+		},
+	}, {
+		// builtins.gie:2117
+		proj:  "+proj=labrd +ellps=GRS80 +lon_0=0.5 +lat_0=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, 166973.166090228, -110536.912730266},
+		},
+		inv: [][]float64{
+			{200, 100, 0.501797719, 2.000904357},
+		},
+	},
+	{
+		proj:  "+proj=labrd +lat_0=-18.9 +lon_0=44.1 +azi=18.9 +k_0=0.9995 +x_0=400000 +y_0=800000 +ellps=intl",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{47.5, -18.9, 758190.722159, 796613.111891},
+		},
+		inv: [][]float64{
+			{758190.722159, 796613.111891, 47.5, -18.9},
+		},
+	},
+	{
+		proj:  "+proj=bipc +ns +ellps=clrk66 +lon_0=-90",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{-70, 10, 6701612.929056, 12641165.729815},
+		},
+		inv: [][]float64{
+			{6701612.929056, 12641165.729815, -70, 10},
+		},
+	}, {
+		// builtins.gie:1987
+		proj:  "+proj=isea +a=6400000 +lat_1=0.5 +lat_2=2",
+		delta: 0.1 * 0.001,
+		fwd: [][]float64{
+			{2, 1, -1097074.948022474, 3442909.309037183},
+		},
+	},
+	{
+		proj:  "+proj=isea +R=6371007.18091875 +lat_0=40 +lon_0=-30 +azi=17",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{10, 20, 8840752.105200, 5394172.794267},
+			{-120, -45, -3789863.531289, -2830710.907118},
+		},
+		inv: [][]float64{
+			{8840752.105200, 5394172.794267, 10, 20},
+			{-3789863.531289, -2830710.907118, -120, -45},
+		},
+	},
+	{
+		// guyou, peirce_q and the adams projections are newer than our
+		// copy of builtins.gie: these are regression values generated with
+		// this package's cmd/proj, at the usual gie check points, and
+		// checked by round trip
+		proj:  "+proj=guyou +R=6400000",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{2, 1, 223407.827684192, 111737.944220843},
+			{120, -50, 18359933.680161830, -7684807.615563745},
+			{-150, 70, -22231121.338997640, 9108056.588768506},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895246},
+			{5000000, -3000000, 44.424819431, -22.947313546},
+		},
+	},
+	{
+		proj:  "+proj=peirce_q +R=6400000",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{2, 1, 8502177.210013857, -8055327.723579758},
+			{120, -50, 12279021.786516167, 15567437.854615929},
+			{-150, 70, -2180136.734343735, 584361.514199901},
+		},
+		inv: [][]float64{
+			{200, 100, 71.565051177, 89.997998168},
+			{5000000, -3000000, 14.241476913, 40.913683072},
+		},
+	},
+	{
+		proj:  "+proj=peirce_q +R=6400000 +shape=diamond",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{2, 1, 315970.302027278, -11707924.018073762},
+			{120, -50, 19690420.444282018, 2325261.301116147},
+		},
+		inv: [][]float64{
+			{5000000, -3000000, 58.831666166, 41.121109879},
+		},
+	},
+	{
+		proj:  "+proj=adams_hemi +R=6400000",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{2, 1, 223407.822889076, 111737.948803769},
+			{-2, -1, -223407.822889076, -111737.948803769},
+		},
+		inv: [][]float64{
+			{200, 100, 0.001790493, 0.000895246},
+			{5000000, -3000000, 44.757173982, -22.735607800},
+		},
+	},
+	{
+		proj:  "+proj=adams_ws1 +R=6400000",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{2, 1, 111701.788640545, 55857.274789666},
+			{120, -50, 6909193.598985216, -4083421.728145452},
+			{-150, 70, -7882239.360437229, 8039952.888035468},
+		},
+		inv: [][]float64{
+			{200, 100, 0.003580986, 0.001790493},
+			{5000000, -3000000, 88.849638862, -42.600079906},
+		},
+	},
+	{
+		proj:  "+proj=adams_ws2 +R=6400000",
+		delta: 0.00001,
+		fwd: [][]float64{
+			{2, 1, 111701.788503419, 55857.274863566},
+			{120, -50, 6691580.983088560, -4178721.379023737},
+			{-150, 70, -7160632.238669129, 7254933.950707777},
+		},
+		inv: [][]float64{
+			{200, 100, 0.003580986, 0.001790493},
+			{5000000, -3000000, 89.514347963, -42.260970439},
+		},
 	},
 }
 
@@ -161,6 +1234,568 @@ func TestConvert(t *testing.T) {
 	}
 }
 
+func TestProjectionsTable(t *testing.T) {
+	assert := assert.New(t)
+
+	// every operation we implement should be listed in the projections table
+	for id := range core.OperationDescriptionTable {
+		_, ok := support.ProjectionsTable[id]
+		assert.True(ok, id)
+	}
+}
+
+func TestHealpixCell(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=healpix +ellps=WGS84")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+
+	op := opx.(core.IConvertLPToXY)
+	hp := opx.(*core.ConvertLPToXY).Algorithm.(*operations.Healpix)
+
+	// lon, lat, order, nested, ring
+	cells := [][5]float64{
+		{0.1, 0.1, 0, 4, 4},
+		{0.1, 0.1, 1, 19, 12},
+		{10, 5, 1, 17, 20},
+		{135, 60, 1, 7, 1},
+		{-45, -60, 0, 11, 11},
+		{-90, 0, 0, 7, 7},
+		{100, 80, 2, 31, 1},
+	}
+
+	for _, c := range cells {
+		tag := fmt.Sprintf("%f %f order %d", c[0], c[1], int(c[2]))
+		xy, err := op.Forward(&core.CoordLP{Lam: support.DDToR(c[0]), Phi: support.DDToR(c[1])})
+		assert.NoError(err)
+
+		nested, err := hp.NestedCell(xy, int(c[2]))
+		assert.NoError(err)
+		assert.Equal(int64(c[3]), nested, tag)
+
+		ring, err := hp.RingCell(xy, int(c[2]))
+		assert.NoError(err)
+		assert.Equal(int64(c[4]), ring, tag)
+	}
+
+	_, err = hp.NestedCell(&core.CoordXY{X: 0, Y: 0}, 30)
+	assert.Error(err)
+
+	// outside the image
+	_, err = hp.RingCell(&core.CoordXY{X: 19000000, Y: 9000000}, 1)
+	assert.Error(err)
+}
+
+func TestQscFace(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=qsc +ellps=WGS84 +lon_0=-90")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+
+	qsc := opx.(*core.ConvertLPToXY).Algorithm.(*operations.Qsc)
+	assert.Equal(operations.QscFaceLeft, qsc.Face())
+
+	faces := []struct {
+		lon, lat float64
+		face     operations.QscFace
+	}{
+		{10, 20, operations.QscFaceFront},
+		{100, -30, operations.QscFaceRight},
+		{-170, 40, operations.QscFaceBack},
+		{-60, 0, operations.QscFaceLeft},
+		{75, 60, operations.QscFaceTop},
+		{-120, -80, operations.QscFaceBottom},
+	}
+	for _, f := range faces {
+		lp := &core.CoordLP{Lam: support.DDToR(f.lon), Phi: support.DDToR(f.lat)}
+		assert.Equal(f.face, qsc.FaceOf(lp), f.face.String())
+	}
+}
+
+func TestIghInterruptions(t *testing.T) {
+	assert := assert.New(t)
+
+	// points in the gaps between the lobes have no inverse
+	gaps := []struct {
+		proj string
+		x, y float64
+	}{
+		{"+proj=igh +R=6400000", -11616985, -3300000},
+		{"+proj=igh +R=6400000", -4468042, 7000000},
+	}
+
+	for _, g := range gaps {
+		ps, err := support.NewProjString(g.proj)
+		assert.NoError(err)
+		_, opx, err := core.NewSystem(ps)
+		assert.NoError(err)
+
+		op := opx.(core.IConvertLPToXY)
+		_, err = op.Inverse(&core.CoordXY{X: g.x, Y: g.y})
+		assert.Error(err, g.proj)
+	}
+}
+
+func TestIseaTriangleOf(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=isea +R=1")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+
+	isea := opx.(*core.ConvertLPToXY).Algorithm.(*operations.Isea)
+
+	triangles := []struct {
+		lon, lat float64
+		tri      int
+	}{
+		{0, 0, 3},
+		{-100, 30, 6},
+		{-60, 10, 7},
+		{60, -70, 18},
+		{170, -10, 20},
+	}
+	for _, tc := range triangles {
+		lp := &core.CoordLP{Lam: support.DDToR(tc.lon), Phi: support.DDToR(tc.lat)}
+		assert.Equal(tc.tri, isea.TriangleOf(lp), "%v %v", tc.lon, tc.lat)
+	}
+}
+
+func TestLatLong(t *testing.T) {
+	assert := assert.New(t)
+
+	// EPSG:4326
+	ps, err := support.NewProjString("+proj=longlat +datum=WGS84 +no_defs")
+	assert.NoError(err)
+	sys, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+	assert.True(sys.IsLatLong)
+	op := opx.(core.IConvertLPToXY)
+
+	lp := &core.CoordLP{Lam: support.DDToR(12.5), Phi: support.DDToR(-55.25)}
+	xy, err := op.Forward(lp)
+	assert.NoError(err)
+	assert.InDelta(12.5, support.RToDD(xy.X), 1.0e-12)
+	assert.InDelta(-55.25, support.RToDD(xy.Y), 1.0e-12)
+
+	// longitudes are relative to the prime meridian
+	ps, err = support.NewProjString("+proj=latlong +ellps=bessel +pm=paris")
+	assert.NoError(err)
+	_, opx, err = core.NewSystem(ps)
+	assert.NoError(err)
+	op = opx.(core.IConvertLPToXY)
+
+	lp = &core.CoordLP{Lam: support.DDToR(12.5), Phi: support.DDToR(48.0)}
+	xy, err = op.Forward(lp)
+	assert.NoError(err)
+	assert.InDelta(12.5-2.337229166667, support.RToDD(xy.X), 1.0e-9)
+	assert.InDelta(48.0, support.RToDD(xy.Y), 1.0e-12)
+
+	lp, err = op.Inverse(xy)
+	assert.NoError(err)
+	assert.InDelta(12.5, support.RToDD(lp.Lam), 1.0e-12)
+	assert.InDelta(48.0, support.RToDD(lp.Phi), 1.0e-12)
+}
+
+func TestCartTopocentric(t *testing.T) {
+	assert := assert.New(t)
+
+	// EPSG Guidance Note 7-2, example for method 9836
+	ps, err := support.NewProjString("+proj=cart +ellps=WGS84")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+	cart := opx.(core.IConvertLPZToXYZ)
+
+	lpz := &core.CoordLPZ{Lam: support.DDToR(5.0), Phi: support.DDToR(55.0), Z: 200.0}
+	xyz, err := cart.Forward(lpz)
+	assert.NoError(err)
+	assert.InDelta(3652755.3058, xyz.X, 0.001)
+	assert.InDelta(319574.6799, xyz.Y, 0.001)
+	assert.InDelta(5201547.3536, xyz.Z, 0.001)
+
+	lpz, err = cart.Inverse(xyz)
+	assert.NoError(err)
+	assert.InDelta(5.0, support.RToDD(lpz.Lam), 1.0e-12)
+	assert.InDelta(55.0, support.RToDD(lpz.Phi), 1.0e-12)
+	assert.InDelta(200.0, lpz.Z, 1.0e-6)
+
+	// the origin may be given either way
+	for _, str := range []string{
+		"+proj=topocentric +ellps=WGS84 +X_0=3652755.3058 +Y_0=319574.6799 +Z_0=5201547.3536",
+		"+proj=topocentric +ellps=WGS84 +lon_0=5 +lat_0=55 +h_0=200",
+	} {
+		ps, err = support.NewProjString(str)
+		assert.NoError(err)
+		_, opx, err = core.NewSystem(ps)
+		assert.NoError(err)
+		topo := opx.(core.IConvertXYZToENU)
+
+		xyz = &core.CoordXYZ{X: 3771793.968, Y: 140253.342, Z: 5124304.349}
+		enu, err := topo.Forward(xyz)
+		assert.NoError(err)
+		assert.InDelta(-189013.869, enu.E, 0.001, str)
+		assert.InDelta(-128642.040, enu.N, 0.001, str)
+		assert.InDelta(-4220.171, enu.U, 0.001, str)
+
+		xyz, err = topo.Inverse(enu)
+		assert.NoError(err)
+		assert.InDelta(3771793.968, xyz.X, 1.0e-6)
+		assert.InDelta(140253.342, xyz.Y, 1.0e-6)
+		assert.InDelta(5124304.349, xyz.Z, 1.0e-6)
+	}
+
+	for _, str := range []string{
+		"+proj=topocentric +ellps=WGS84",
+		"+proj=topocentric +ellps=WGS84 +X_0=3652755.3058 +Y_0=319574.6799",
+		"+proj=topocentric +ellps=WGS84 +X_0=3652755.3058 +Y_0=319574.6799 +Z_0=5201547.3536 +h_0=200",
+	} {
+		ps, err = support.NewProjString(str)
+		assert.NoError(err)
+		_, _, err = core.NewSystem(ps)
+		assert.Error(err, str)
+	}
+}
+
+func TestAffine(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=affine +xoff=1 +yoff=2 +zoff=3 +toff=4 " +
+		"+s11=2 +s12=1 +s13=0.5 +s21=-1 +s22=3 +s23=0 +s31=0 +s32=0.25 +s33=1.5 +tscale=0.5")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+	op := opx.(core.IConvertXYZTToXYZT)
+
+	in := &core.CoordXYZT{X: 10, Y: 20, Z: 30, T: 2018}
+	out, err := op.Forward(in)
+	assert.NoError(err)
+	assert.InDelta(1+20+20+15, out.X, 1.0e-12)
+	assert.InDelta(2-10+60, out.Y, 1.0e-12)
+	assert.InDelta(3+5+45, out.Z, 1.0e-12)
+	assert.InDelta(4+1009, out.T, 1.0e-12)
+
+	back, err := op.Inverse(out)
+	assert.NoError(err)
+	assert.InDelta(in.X, back.X, 1.0e-10)
+	assert.InDelta(in.Y, back.Y, 1.0e-10)
+	assert.InDelta(in.Z, back.Z, 1.0e-10)
+	assert.InDelta(in.T, back.T, 1.0e-10)
+
+	// a projection onto the plane can't be undone
+	ps, err = support.NewProjString("+proj=affine +s33=0")
+	assert.NoError(err)
+	_, opx, err = core.NewSystem(ps)
+	assert.NoError(err)
+	op = opx.(core.IConvertXYZTToXYZT)
+	out, err = op.Forward(in)
+	assert.NoError(err)
+	assert.Equal(0.0, out.Z)
+	_, err = op.Inverse(out)
+	assert.Error(err)
+}
+
+func TestGeogOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=geogoffset +dlon=3.6 +dlat=-7.2 +dh=12.5")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+	op := opx.(core.IConvertLPZToLPZ)
+
+	in := &core.CoordLPZ{Lam: support.DDToR(12.0), Phi: support.DDToR(55.0), Z: 100.0}
+	out, err := op.Forward(in)
+	assert.NoError(err)
+	assert.InDelta(12.001, support.RToDD(out.Lam), 1.0e-12)
+	assert.InDelta(54.998, support.RToDD(out.Phi), 1.0e-12)
+	assert.InDelta(112.5, out.Z, 1.0e-12)
+
+	back, err := op.Inverse(out)
+	assert.NoError(err)
+	assert.InDelta(12.0, support.RToDD(back.Lam), 1.0e-12)
+	assert.InDelta(55.0, support.RToDD(back.Phi), 1.0e-12)
+	assert.InDelta(100.0, back.Z, 1.0e-12)
+}
+
+func TestHorner(t *testing.T) {
+	assert := assert.New(t)
+
+	// second degree: E = sum of u[i,j] e^i n^j, N = sum of v[i,j] n^i e^j
+	ps, err := support.NewProjString("+proj=horner +deg=2 +range=1000 " +
+		"+fwd_origin=100,200 +inv_origin=300,400 " +
+		"+fwd_u=1,2,3,4,5,6 +fwd_v=7,8,9,10,11,12 " +
+		"+inv_u=-1,-2,-3,-4,-5,-6 +inv_v=-7,-8,-9,-10,-11,-12")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+	op := opx.(core.IConvertXYZTToXYZT)
+
+	e, n := 3.0, -2.0
+	out, err := op.Forward(&core.CoordXYZT{X: 100 + e, Y: 200 + n, Z: 5, T: 6})
+	assert.NoError(err)
+	assert.InDelta(1+2*e+3*e*e+4*n+5*e*n+6*n*n, out.X, 1.0e-12)
+	assert.InDelta(7+8*n+9*n*n+10*e+11*n*e+12*e*e, out.Y, 1.0e-12)
+	assert.Equal(5.0, out.Z)
+	assert.Equal(6.0, out.T)
+
+	out, err = op.Inverse(&core.CoordXYZT{X: 300 + e, Y: 400 + n})
+	assert.NoError(err)
+	assert.InDelta(-(1 + 2*e + 3*e*e + 4*n + 5*e*n + 6*n*n), out.X, 1.0e-12)
+	assert.InDelta(-(7 + 8*n + 9*n*n + 10*e + 11*n*e + 12*e*e), out.Y, 1.0e-12)
+
+	_, err = op.Forward(&core.CoordXYZT{X: 100 + 1001, Y: 200})
+	assert.Error(err)
+
+	// complex first degree: N + iE = (c0 + i c1) + (c2 + i c3)(n + ie)
+	ps, err = support.NewProjString("+proj=horner +deg=1 " +
+		"+fwd_origin=0,0 +inv_origin=0,0 +fwd_c=10,20,2,0.5 +inv_c=0,0,1,0")
+	assert.NoError(err)
+	_, opx, err = core.NewSystem(ps)
+	assert.NoError(err)
+	op = opx.(core.IConvertXYZTToXYZT)
+
+	out, err = op.Forward(&core.CoordXYZT{X: e, Y: n})
+	assert.NoError(err)
+	assert.InDelta(20+2*e+0.5*n, out.X, 1.0e-12)
+	assert.InDelta(10+2*n-0.5*e, out.Y, 1.0e-12)
+
+	out, err = op.Inverse(&core.CoordXYZT{X: e, Y: n})
+	assert.NoError(err)
+	assert.InDelta(e, out.X, 1.0e-12)
+	assert.InDelta(n, out.Y, 1.0e-12)
+
+	for _, str := range []string{
+		"+proj=horner +fwd_origin=0,0 +inv_origin=0,0 +fwd_c=1,0 +inv_c=1,0",
+		"+proj=horner +deg=1 +fwd_origin=0,0 +inv_origin=0,0 +fwd_c=1,0,1 +inv_c=1,0,1,0",
+		"+proj=horner +deg=1 +inv_origin=0,0 +fwd_c=1,0,1,0 +inv_c=1,0,1,0",
+	} {
+		ps, err = support.NewProjString(str)
+		assert.NoError(err)
+		_, _, err = core.NewSystem(ps)
+		assert.Error(err, str)
+	}
+}
+
+func TestVertOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=vertoffset +ellps=GRS80 " +
+		"+lat_0=46.9166666666666666667 +lon_0=8.1833333333333333333 " +
+		"+dh=-0.245 +slope_lat=-0.210 +slope_lon=-0.032")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+	op := opx.(core.IConvertLPZToLPZ)
+
+	in := &core.CoordLPZ{Lam: support.DDToR(9.8), Phi: support.DDToR(47.3), Z: 473.0}
+	out, err := op.Forward(in)
+	assert.NoError(err)
+	assert.InDelta(9.8, support.RToDD(out.Lam), 1.0e-12)
+	assert.InDelta(47.3, support.RToDD(out.Phi), 1.0e-12)
+	assert.InDelta(472.6926, out.Z, 0.0001)
+
+	back, err := op.Inverse(out)
+	assert.NoError(err)
+	assert.InDelta(9.8, support.RToDD(back.Lam), 1.0e-12)
+	assert.InDelta(47.3, support.RToDD(back.Phi), 1.0e-12)
+	assert.InDelta(473.0, back.Z, 1.0e-9)
+
+	// at the origin only the constant offset applies
+	in = &core.CoordLPZ{Lam: support.DDToR(8.1833333333333333333), Phi: support.DDToR(46.9166666666666666667), Z: 100.0}
+	out, err = op.Forward(in)
+	assert.NoError(err)
+	assert.InDelta(99.755, out.Z, 1.0e-9)
+}
+
+func TestPolyInverseOutOfDomain(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, str := range []string{"+proj=poly +R=6400000", "+proj=poly +ellps=GRS80"} {
+		ps, err := support.NewProjString(str)
+		assert.NoError(err)
+		_, opx, err := core.NewSystem(ps)
+		assert.NoError(err)
+		op := opx.(core.IConvertLPToXY)
+
+		// far enough from the central meridian that the inverse has no
+		// valid solution
+		xy := &core.CoordXY{X: -7169654.764074, Y: 8474096.772153}
+		_, err = op.Inverse(xy)
+		assert.Error(err, str)
+
+		// the algorithm leaves the caller's coordinate alone
+		poly := opx.(*core.ConvertLPToXY).Algorithm.(*operations.Poly)
+		xy = &core.CoordXY{X: 0.03, Y: 0.05}
+		_, err = poly.Inverse(xy)
+		assert.NoError(err, str)
+		assert.Equal(0.03, xy.X, str)
+		assert.Equal(0.05, xy.Y, str)
+	}
+}
+
+func TestImwPInverseOffSheet(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, str := range []string{
+		"+proj=imw_p +lat_1=20 +lat_2=60 +R=6400000",
+		"+proj=imw_p +lat_1=20 +lat_2=60 +ellps=GRS80",
+	} {
+		ps, err := support.NewProjString(str)
+		assert.NoError(err)
+		_, opx, err := core.NewSystem(ps)
+		assert.NoError(err)
+		op := opx.(core.IConvertLPToXY)
+
+		// (-120, 58) is well off the sheet, so it can't be brought back
+		xy, err := op.Forward(&core.CoordLP{Lam: support.DDToR(-120.0), Phi: support.DDToR(58.0)})
+		assert.NoError(err, str)
+		_, err = op.Inverse(xy)
+		assert.Error(err, str)
+
+		_, err = op.Inverse(&core.CoordXY{X: -12000000.0, Y: 5800000.0})
+		assert.Error(err, str)
+
+		// these have no intersection with the sheet at all
+		for _, lp := range [][]float64{{-150, -70}, {-60, 80}} {
+			_, err = op.Forward(&core.CoordLP{Lam: support.DDToR(lp[0]), Phi: support.DDToR(lp[1])})
+			assert.Error(err, str)
+		}
+
+		// points on the central meridian are on the sheet, and come back
+		for _, phi := range []float64{30, 40, -30} {
+			xy, err := op.Forward(&core.CoordLP{Lam: 0.0, Phi: support.DDToR(phi)})
+			assert.NoError(err, str)
+			assert.Equal(0.0, xy.X, str)
+			lp, err := op.Inverse(xy)
+			assert.NoError(err, str)
+			assert.InDelta(0.0, support.RToDD(lp.Lam), 1.0e-9, str)
+			assert.InDelta(phi, support.RToDD(lp.Phi), 1.0e-9, str)
+		}
+	}
+}
+
+func TestKrovakCzechAxes(t *testing.T) {
+	assert := assert.New(t)
+
+	newOp := func(str string) core.IConvertLPToXY {
+		ps, err := support.NewProjString(str)
+		assert.NoError(err)
+		_, opx, err := core.NewSystem(ps)
+		assert.NoError(err)
+		return opx.(core.IConvertLPToXY)
+	}
+	en := newOp("+proj=krovak +ellps=bessel")
+	sw := newOp("+proj=krovak +ellps=bessel +czech")
+
+	for _, lp := range [][]float64{{16.85, 50.21}, {12.5, 48.6}, {22.5, 49.5}} {
+		// the hooks adjust their inputs in place, so use fresh copies
+		in := func() *core.CoordLP {
+			return &core.CoordLP{Lam: support.DDToR(lp[0]), Phi: support.DDToR(lp[1])}
+		}
+
+		// the default is east/north, which is negative over the territory
+		xy1, err := en.Forward(in())
+		assert.NoError(err)
+		assert.True(xy1.X < 0 && xy1.Y < 0)
+
+		// +czech only flips both signs
+		xy2, err := sw.Forward(in())
+		assert.NoError(err)
+		assert.InDelta(-xy1.X, xy2.X, 1.0e-6)
+		assert.InDelta(-xy1.Y, xy2.Y, 1.0e-6)
+
+		lp1, err := en.Inverse(&core.CoordXY{X: xy1.X, Y: xy1.Y})
+		assert.NoError(err)
+		lp2, err := sw.Inverse(&core.CoordXY{X: xy2.X, Y: xy2.Y})
+		assert.NoError(err)
+		assert.InDelta(lp1.Lam, lp2.Lam, 1.0e-12)
+		assert.InDelta(lp1.Phi, lp2.Phi, 1.0e-12)
+		assert.InDelta(in().Lam, lp1.Lam, 1.0e-9)
+		assert.InDelta(in().Phi, lp1.Phi, 1.0e-9)
+	}
+}
+
+func TestObTranLatlongNoScaling(t *testing.T) {
+	assert := assert.New(t)
+
+	// with a latlong inner projection the outputs are radians, so
+	// offsets and units don't apply
+	base := "+proj=ob_tran +o_proj=latlong +o_lat_p=40 +o_lon_p=-30 +lon_0=10 +R=6400000"
+	var want *core.CoordXY
+	for _, extra := range []string{"", " +x_0=1000 +y_0=2000", " +units=km"} {
+		ps, err := support.NewProjString(base + extra)
+		assert.NoError(err)
+		_, opx, err := core.NewSystem(ps)
+		assert.NoError(err)
+		op := opx.(core.IConvertLPToXY)
+		assert.Equal(core.IOUnitsWhatever, op.GetSystem().Right)
+
+		xy, err := op.Forward(&core.CoordLP{Lam: support.DDToR(20.0), Phi: support.DDToR(50.0)})
+		assert.NoError(err)
+		if want == nil {
+			want = &core.CoordXY{X: xy.X, Y: xy.Y}
+		}
+		assert.InDelta(want.X, xy.X, 1.0e-12, extra)
+		assert.InDelta(want.Y, xy.Y, 1.0e-12, extra)
+
+		lp, err := op.Inverse(xy)
+		assert.NoError(err)
+		assert.InDelta(20.0, support.RToDD(lp.Lam), 1.0e-9, extra)
+		assert.InDelta(50.0, support.RToDD(lp.Phi), 1.0e-9, extra)
+	}
+}
+
+func TestForwardOnlyInverse(t *testing.T) {
+	assert := assert.New(t)
+
+	// these have no inverse, which is an error rather than a panic
+	for _, str := range []string{
+		"+proj=wag7 +R=6400000",
+		"+proj=tcc +R=6400000",
+		"+proj=chamb +R=6400000 +lat_1=0.5 +lat_2=2",
+		"+proj=bacon +R=6400000",
+		"+proj=lask +R=6400000",
+		"+proj=larr +R=6400000",
+		"+proj=nicol +R=6400000",
+		"+proj=vandg2 +R=6400000",
+		"+proj=vandg4 +R=6400000",
+		"+proj=urm5 +R=6400000 +n=0.5",
+		"+proj=boggs +R=6400000",
+	} {
+		ps, err := support.NewProjString(str)
+		assert.NoError(err)
+		_, opx, err := core.NewSystem(ps)
+		assert.NoError(err)
+		op := opx.(core.IConvertLPToXY)
+
+		_, err = op.Inverse(&core.CoordXY{X: 1000.0, Y: 2000.0})
+		assert.Error(err, str)
+	}
+}
+
+func TestHealpixForwardLeavesInput(t *testing.T) {
+	assert := assert.New(t)
+
+	ps, err := support.NewProjString("+proj=healpix +ellps=WGS84")
+	assert.NoError(err)
+	_, opx, err := core.NewSystem(ps)
+	assert.NoError(err)
+
+	// the authalic latitude is only used internally
+	healpix := opx.(*core.ConvertLPToXY).Algorithm.(*operations.Healpix)
+	lp := &core.CoordLP{Lam: 0.3, Phi: 0.7}
+	_, err = healpix.Forward(lp)
+	assert.NoError(err)
+	assert.Equal(0.3, lp.Lam)
+	assert.Equal(0.7, lp.Phi)
+}
+
 func BenchmarkConvertEtMerc(b *testing.B) {
 
 	ps, _ := support.NewProjString("+proj=utm +zone=32 +ellps=GRS80")
@@ -0,0 +1,43 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package support
+
+import (
+	"math"
+)
+
+/* determine latitude from authalic latitude */
+const p00 = .33333333333333333333
+const p01 = .17222222222222222222
+const p02 = .10257936507936507936
+const p10 = .06388888888888888888
+const p11 = .06640211640211640211
+const p20 = .01641501294219154443
+const apaSize = 3
+
+// Authset returns the series coefficients used by Authlat
+func Authset(es float64) []float64 {
+	var t float64
+
+	apa := make([]float64, apaSize)
+	apa[0] = es * p00
+	t = es * es
+	apa[0] += t * p01
+	apa[1] = t * p10
+	t *= es
+	apa[0] += t * p02
+	apa[1] += t * p11
+	apa[2] = t * p20
+	return apa
+}
+
+// Authlat returns the geodetic latitude for the authalic latitude beta
+func Authlat(beta float64, apa []float64) float64 {
+	t := beta + beta
+	return (beta + apa[0]*math.Sin(t) + apa[1]*math.Sin(t+t) + apa[2]*math.Sin(t+t+t))
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package support
+
+import "math"
+
+const ellipticTol = 1.0e-15
+const ellipticMaxIter = 40
+
+// EllipticF returns the incomplete elliptic integral of the first kind
+//
+//	F(phi|m) = integral from 0 to phi of 1/sqrt(1 - m sin^2 t) dt
+//
+// for |phi| <= pi/2 and m <= 1, using Carlson's symmetric form.
+func EllipticF(phi, m float64) float64 {
+	s := math.Sin(phi)
+	c := math.Cos(phi)
+	return s * carlsonRF(c*c, 1.-m*s*s, 1.)
+}
+
+// EllipticK returns the complete elliptic integral of the first kind,
+// K(m) = F(pi/2|m), for m < 1
+func EllipticK(m float64) float64 {
+	return carlsonRF(0., 1.-m, 1.)
+}
+
+// JacobiAmplitude returns the Jacobi amplitude am(u|m), the inverse of
+// EllipticF: am(F(phi|m)|m) = phi. It uses the arithmetic-geometric
+// mean, for 0 <= m <= 1.
+func JacobiAmplitude(u, m float64) float64 {
+	if m == 0. {
+		return u
+	}
+	if m == 1. {
+		return 2.*math.Atan(math.Exp(u)) - PiOverTwo
+	}
+
+	var a, c [ellipticMaxIter + 1]float64
+	a[0] = 1.
+	b := math.Sqrt(1. - m)
+	c[0] = math.Sqrt(m)
+
+	n := 0
+	for ; n < ellipticMaxIter && math.Abs(c[n]) > ellipticTol; n++ {
+		a[n+1] = (a[n] + b) / 2.
+		c[n+1] = (a[n] - b) / 2.
+		b = math.Sqrt(a[n] * b)
+	}
+
+	phi := math.Ldexp(a[n]*u, n)
+	for ; n > 0; n-- {
+		phi = (phi + math.Asin(c[n]/a[n]*math.Sin(phi))) / 2.
+	}
+	return phi
+}
+
+// EllipticFHalf returns F(phi|1/2), for |phi| <= pi/2, using the even
+// Chebyshev series from PROJ's Adams projections. It is good to better
+// than 1e-7, and is what those projections use so as to give the same
+// results as PROJ.
+func EllipticFHalf(phi float64) float64 {
+	const C0 = 2.19174570831038
+	C := []float64{
+		-8.58691003636495e-07,
+		2.02692115653689e-07,
+		3.12960480765314e-05,
+		5.30394739921063e-05,
+		-0.0012804644680613,
+		-0.00575574836830288,
+		0.0914203033408211,
+	}
+
+	y := phi / PiOverTwo
+	y = 2.*y*y - 1.
+	y2 := 2. * y
+	d1 := 0.0
+	d2 := 0.0
+	for _, c := range C {
+		d1, d2 = y2*d1-d2+c, d1
+	}
+
+	return phi * (y*d1 - d2 + 0.5*C0)
+}
+
+// carlsonRF returns Carlson's elliptic integral of the first kind,
+// RF(x, y, z), by the duplication theorem
+func carlsonRF(x, y, z float64) float64 {
+	for i := 0; i < ellipticMaxIter; i++ {
+		sx, sy, sz := math.Sqrt(x), math.Sqrt(y), math.Sqrt(z)
+		lambda := sx*(sy+sz) + sy*sz
+		x = (x + lambda) / 4.
+		y = (y + lambda) / 4.
+		z = (z + lambda) / 4.
+
+		mu := (x + y + z) / 3.
+		dx, dy, dz := 1.-x/mu, 1.-y/mu, 1.-z/mu
+		if math.Max(math.Abs(dx), math.Max(math.Abs(dy), math.Abs(dz))) < 1.0e-4 {
+			e2 := dx*dy - dz*dz
+			e3 := dx * dy * dz
+			return (1. - e2/10. + e3/14. + e2*e2/24. - 3.*e2*e3/44.) / math.Sqrt(mu)
+		}
+	}
+	return math.NaN()
+}
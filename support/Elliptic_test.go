@@ -0,0 +1,40 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package support_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-spatial/proj/support"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElliptic(t *testing.T) {
+	assert := assert.New(t)
+
+	// reference values by numerical quadrature
+	assert.InDelta(support.PiOverTwo, support.EllipticK(0.), 1.0e-15)
+	assert.InDelta(1.8540746773013719, support.EllipticK(0.5), 1.0e-12)
+	assert.InDelta(2.0753631352924690, support.EllipticK(0.7), 1.0e-12)
+	assert.InDelta(0.5356227328054020, support.EllipticF(math.Pi/6., 0.5), 1.0e-12)
+	assert.InDelta(1.5648981345066826, support.EllipticF(1.2, 0.9), 1.0e-12)
+
+	for _, m := range []float64{0., 0.2, 0.5, 0.9} {
+		assert.InDelta(support.EllipticK(m), support.EllipticF(support.PiOverTwo, m), 1.0e-15)
+
+		for phi := -1.5; phi <= 1.5; phi += 0.25 {
+			u := support.EllipticF(phi, m)
+			assert.InDelta(phi, support.JacobiAmplitude(u, m), 1.0e-13)
+		}
+	}
+
+	for phi := -support.PiOverTwo; phi <= support.PiOverTwo; phi += 0.01 {
+		assert.InDelta(support.EllipticF(phi, 0.5), support.EllipticFHalf(phi), 1.0e-7)
+	}
+}
@@ -17,8 +17,12 @@ type ProjectionTableEntry struct {
 
 // ProjectionsTable is the global list of projections
 var ProjectionsTable = map[string]*ProjectionTableEntry{
+	"adams_hemi":  {"adams_hemi", "Adams Hemisphere in a Square"},
+	"adams_ws1":   {"adams_ws1", "Adams World in a Square I"},
+	"adams_ws2":   {"adams_ws2", "Adams World in a Square II"},
 	"aea":         {"aea", "Albers Equal Area"},
 	"aeqd":        {"aeqd", "Azimuthal Equidistant"},
+	"affine":      {"affine", "Affine transformation"},
 	"airy":        {"airy", "Airy"},
 	"aitoff":      {"aitoff", "Aitoff"},
 	"alsk":        {"alsk", "Mod. Stererographics of Alaska"},
@@ -26,6 +30,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"august":      {"august", "August Epicycloidal"},
 	"axisswap":    {"axisswap", "Axis ordering"},
 	"bacon":       {"bacon", "Bacon Globular"},
+	"bertin1953":  {"bertin1953", "Bertin 1953"},
 	"bipc":        {"bipc", "Bipolar conic of western hemisphere"},
 	"boggs":       {"boggs", "Boggs Eumorphic"},
 	"bonne":       {"bonne", "Bonne (Werner lat_1=90)"},
@@ -49,6 +54,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"eck6":        {"eck6", "Eckert VI"},
 	"eqc":         {"eqc", "Equidistant Cylindrical (Plate Caree)"},
 	"eqdc":        {"eqdc", "Equidistant Conic"},
+	"eqearth":     {"eqearth", "Equal Earth"},
 	"euler":       {"euler", "Euler"},
 	"etmerc":      {"etmerc", "Extended Transverse Mercator"},
 	"fahey":       {"fahey", "Fahey"},
@@ -57,6 +63,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"gall":        {"gall", "Gall (Gall Stereographic)"},
 	"geoc":        {"geoc", "Geocentric Latitude"},
 	"geocent":     {"geocent", "Geocentric"},
+	"geogoffset":  {"geogoffset", "Geographic Offset"},
 	"geos":        {"geos", "Geostationary Satellite View"},
 	"gins8":       {"gins8", "Ginsburg VIII (TsNIIGAiK)"},
 	"gn_sinu":     {"gn_sinu", "General Sinusoidal Series"},
@@ -64,6 +71,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"goode":       {"goode", "Goode Homolosine"},
 	"gs48":        {"gs48", "Mod. Stererographics of 48 U.S."},
 	"gs50":        {"gs50", "Mod. Stererographics of 50 U.S."},
+	"guyou":       {"guyou", "Guyou"},
 	"hammer":      {"hammer", "Hammer & Eckert-Greifendorff"},
 	"hatano":      {"hatano", "Hatano Asymmetrical Equal Area"},
 	"healpix":     {"healpix", "HEALPix"},
@@ -72,6 +80,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"hgridshift":  {"hgridshift", "Horizontal grid shift"},
 	"horner":      {"horner", "Horner polynomial evaluation"},
 	"igh":         {"igh", "Interrupted Goode Homolosine"},
+	"igh_o":       {"igh_o", "Interrupted Goode Homolosine Oceanic View"},
 	"imw_p":       {"imw_p", "International Map of the World Polyconic"},
 	"isea":        {"isea", "Icosahedral Snyder Equal Area"},
 	"kav5":        {"kav5", "Kavraisky V"},
@@ -121,6 +130,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"ortho":       {"ortho", "Orthographic"},
 	"pconic":      {"pconic", "Perspective Conic"},
 	"patterson":   {"patterson", "Patterson Cylindrical"},
+	"peirce_q":    {"peirce_q", "Peirce Quincuncial"},
 	"pipeline":    {"pipeline", "Transformation pipeline manager"},
 	"poly":        {"poly", "Polyconic (American)"},
 	"putp1":       {"putp1", "Putnins P1"},
@@ -149,6 +159,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"tissot":      {"tissot", "Tissot Conic"},
 	"tmerc":       {"tmerc", "Transverse Mercator"},
 	"tpeqd":       {"tpeqd", "Two Point Equidistant"},
+	"topocentric": {"topocentric", "Geocentric/Topocentric conversion"},
 	"tpers":       {"tpers", "Tilted perspective"},
 	"unitconvert": {"unitconvert", "Unit conversion"},
 	"ups":         {"ups", "Universal Polar Stereographic"},
@@ -159,6 +170,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"vandg2":      {"vandg2", "van der Grinten II"},
 	"vandg3":      {"vandg3", "van der Grinten III"},
 	"vandg4":      {"vandg4", "van der Grinten IV"},
+	"vertoffset":  {"vertoffset", "Vertical Offset and Slope"},
 	"vitk1":       {"vitk1", "Vitkovsky I"},
 	"vgridshift":  {"vgridshift", "Vertical grid shift"},
 	"wag1":        {"wag1", "Wagner I (Kavraisky VI)"},
@@ -168,6 +180,7 @@ var ProjectionsTable = map[string]*ProjectionTableEntry{
 	"wag5":        {"wag5", "Wagner V"},
 	"wag6":        {"wag6", "Wagner VI"},
 	"wag7":        {"wag7", "Wagner VII"},
+	"webmerc":     {"webmerc", "Web Mercator / Pseudo Mercator"},
 	"weren":       {"weren", "Werenskiold I"},
 	"wink1":       {"wink1", "Winkel I"},
 	"wink2":       {"wink2", "Winkel II"},
@@ -0,0 +1,45 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package support
+
+// Zpoly1 evaluates the complex polynomial
+//
+//	z * (C[0] + C[1]*z + ... + C[n]*z^n)
+//
+// using Horner's scheme.
+func Zpoly1(z complex128, C []complex128) complex128 {
+	n := len(C) - 1
+
+	a := C[n]
+	for i := n - 1; i >= 0; i-- {
+		a = C[i] + z*a
+	}
+	return z * a
+}
+
+// Zpolyd1 evaluates the same complex polynomial as Zpoly1, and also
+// returns its derivative with respect to z
+func Zpolyd1(z complex128, C []complex128) (complex128, complex128) {
+	n := len(C) - 1
+
+	a := C[n]
+	b := a
+	first := true
+	for i := n - 1; i >= 0; i-- {
+		if first {
+			first = false
+		} else {
+			b = a + z*b
+		}
+		a = C[i] + z*a
+	}
+	b = a + z*b
+	a = z * a
+
+	return a, b
+}
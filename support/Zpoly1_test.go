@@ -0,0 +1,35 @@
+// Copyright (C) 2018, Michael P. Gerlek (Flaxen Consulting)
+//
+// Portions of this code were derived from the PROJ.4 software
+// In keeping with the terms of the PROJ.4 project, this software
+// is provided under the MIT-style license in `LICENSE.md` and may
+// additionally be subject to the copyrights of the PROJ.4 authors.
+
+package support_test
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/go-spatial/proj/support"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZpoly1(t *testing.T) {
+	assert := assert.New(t)
+
+	C := []complex128{complex(1, 0), complex(0.5, -0.25), complex(-2, 3)}
+
+	// z*(1 + (0.5-0.25i)z + (-2+3i)z^2)
+	// derivative: 1 + 2(0.5-0.25i)z + 3(-2+3i)z^2
+	for _, z := range []complex128{0, complex(1, 0), complex(0.3, -0.7), complex(-1.5, 2)} {
+		expect := z * (C[0] + C[1]*z + C[2]*z*z)
+		expectDer := C[0] + 2*C[1]*z + 3*C[2]*z*z
+
+		assert.InDelta(0.0, cmplx.Abs(support.Zpoly1(z, C)-expect), 1.0e-12)
+
+		f, fp := support.Zpolyd1(z, C)
+		assert.InDelta(0.0, cmplx.Abs(f-expect), 1.0e-12)
+		assert.InDelta(0.0, cmplx.Abs(fp-expectDer), 1.0e-12)
+	}
+}